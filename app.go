@@ -1,13 +1,17 @@
 package gu
 
 import (
+	"context"
 	"fmt"
 	"html/template"
+	"sync"
 
+	"github.com/gu-io/gu/common"
 	"github.com/gu-io/gu/drivers/core"
 	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/router"
 	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
 	"github.com/gu-io/gu/trees/elems"
 )
 
@@ -17,6 +21,8 @@ type NApp struct {
 	active         bool
 	title          string
 	uuid           string
+	seed           string
+	keys           *KeyGenerator
 	location       Location
 	views          []*NView
 	activeViews    []*NView
@@ -25,21 +31,51 @@ type NApp struct {
 	router         *router.Router
 	resourceHeader []*trees.Markup
 	resourceBody   []*trees.Markup
+	ml             sync.RWMutex // guards views, resources, themeVariant and locale.
+	themeVariant   string
+	locale         string
+	scheduler      *Scheduler
+	values         *Values
 }
 
-// App creates a new app structure to rendering gu components.
+// seedSize defines the size of the random seeds of apps created with App.
+const seedSize = 16
+
+// App creates a new app structure to rendering gu components, using a random
+// seed for the keys of the app, so apps with the same title are told apart. Use
+// SeededApp when the keys must match the keys of another instance of the app.
 func App(title string, router *router.Router) *NApp {
+	return SeededApp(trees.RandString(seedSize), title, router)
+}
+
+// SeededApp creates a new app structure to rendering gu components, where the
+// keys of the app, its views and components are derived from the seed and
+// their position within the app. Apps created from the same seed and with the
+// same views and components have the same keys, which allows matching html
+// rendered on the server with the client. The seed is rendered into the head of
+// the app as the app-seed attribute of a meta element, so a client can create
+// its app from the seed of the html it adopts.
+func SeededApp(seed string, title string, router *router.Router) *NApp {
 	var app NApp
 	app.title = title
-	app.uuid = NewKey()
+	app.seed = seed
+	app.keys = NewKeyGenerator(seed)
+	app.uuid = app.keys.Key("")
 	app.router = router
 	app.notifications = notifications.AppNotification(app.uuid)
+	app.scheduler = NewScheduler(IntervalFrames(FrameInterval))
+	app.values = NewValues(nil)
 
 	var head []*trees.Markup
 	head = append(head, elems.Title(elems.Text(app.title)))
 	head = append(head, elems.Meta(trees.NewAttr("app-id", app.uuid)))
+	head = append(head, elems.Meta(trees.NewAttr("app-seed", app.seed)))
 	head = append(head, elems.Meta(trees.NewAttr("charset", "utf-8")))
 
+	for _, resource := range head {
+		app.stampResource(resource)
+	}
+
 	app.resourceHeader = head
 
 	return &app
@@ -85,6 +121,57 @@ func (app *NApp) initSanitCheck() {
 	app.location = NewNoopLocation(app)
 }
 
+// UseThemeVariant sets the theme variant (e.g common.DarkVariant) which is set
+// as the common.ThemeVariantAttr attribute of the root html element, switching
+// the custom properties generated by the styleguide. An empty variant removes
+// the attribute, letting the user's preferences decide. It notifies the app to
+// be updated with the new variant.
+func (app *NApp) UseThemeVariant(variant string) {
+	app.ml.Lock()
+	app.themeVariant = variant
+	app.ml.Unlock()
+
+	notifications.Dispatch(AppUpdate{
+		App: app,
+	})
+}
+
+// ThemeVariant returns the theme variant currently used by the app.
+func (app *NApp) ThemeVariant() string {
+	app.ml.RLock()
+	defer app.ml.RUnlock()
+
+	return app.themeVariant
+}
+
+// UseLocale sets the locale of the app (e.g "ar-EG"), which is set as the `lang`
+// attribute of the root html element along with the `dir` attribute of its
+// writing direction. Stylesheets of views rendered under a right-to-left locale
+// have their directional properties flipped. It notifies the app to be updated
+// with the new locale.
+func (app *NApp) UseLocale(locale string) {
+	app.ml.Lock()
+	app.locale = locale
+	app.ml.Unlock()
+
+	notifications.Dispatch(AppUpdate{
+		App: app,
+	})
+}
+
+// Locale returns the locale currently used by the app.
+func (app *NApp) Locale() string {
+	app.ml.RLock()
+	defer app.ml.RUnlock()
+
+	return app.locale
+}
+
+// Direction returns the writing direction of the app's locale.
+func (app *NApp) Direction() css.Direction {
+	return css.DirectionFor(app.Locale())
+}
+
 // Active returns true/false if the giving app is active and has already
 // received rendering.
 func (app *NApp) Active() bool {
@@ -120,11 +207,14 @@ func (app *NApp) ActivateRoute(es interface{}) {
 // AppJSON defines a struct which holds the giving sets of tree changes to be
 // rendered.
 type AppJSON struct {
-	AppID         string             `json:"AppId"`
+	AppID         string             `json:"AppID"`
 	Name          string             `json:"Name"`
 	Title         string             `json:"Title"`
 	Head          []ViewJSON         `json:"Head"`
 	Body          []ViewJSON         `json:"Body"`
+	ThemeVariant  string             `json:"ThemeVariant"`
+	Locale        string             `json:"Locale"`
+	Direction     string             `json:"Direction"`
 	HeadResources []trees.MarkupJSON `json:"HeadResources"`
 	BodyResources []trees.MarkupJSON `json:"BodyResources"`
 }
@@ -137,7 +227,14 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 	}
 
 	var tjson AppJSON
+	tjson.AppID = app.uuid
 	tjson.Name = app.title
+	tjson.ThemeVariant = app.ThemeVariant()
+
+	if locale := app.Locale(); locale != "" {
+		tjson.Locale = locale
+		tjson.Direction = string(css.DirectionFor(locale))
+	}
 
 	toHead, toBody := app.Resources()
 
@@ -164,10 +261,7 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 
 	tjson.Body = append(tjson.Body, afterBody...)
 
-	script := trees.NewMarkup("script", false)
-	trees.NewAttr("type", "text/javascript").Apply(script)
-	trees.NewText(core.JavascriptDriverCore).Apply(script)
-	tjson.BodyResources = append(tjson.BodyResources, script.TreeJSON())
+	tjson.BodyResources = append(tjson.BodyResources, app.coreScript().TreeJSON())
 
 	return tjson
 }
@@ -179,9 +273,106 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 		app.ActivateRoute(es)
 	}
 
+	toHead, toBody := app.Resources()
+
+	return app.document(app.activeViews, toHead, toBody, (*NView).Render)
+}
+
+// RenderFor renders the app for the provided route within a new RenderContext
+// which holds the matched views and their rendered trees, leaving the active
+// views, location and live trees of the app and its components untouched. This
+// allows a single app to render different routes from many goroutines e.g when
+// serving requests. Routes are tested against the views without being resolved,
+// so subscribers of the view and component routers are not notified. It returns
+// the error of the context if it is done before rendering completes. As many
+// goroutines render the same views, the Renderables of the views and their
+// components must be safe to Render concurrently.
+func (app *NApp) RenderFor(ctx context.Context, pe router.PushEvent) (*RenderContext, error) {
+	rc := RenderContext{
+		Context:  ctx,
+		Location: pe,
+		trees:    make(map[*NView]*trees.Markup),
+	}
+
+	for _, view := range app.Views() {
+		if _, _, ok := view.router.Test(pe.Rem); ok {
+			rc.Views = append(rc.Views, view)
+		}
+	}
+
+	for _, view := range rc.Views {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rc.trees[view] = view.renderScoped(ctx)
+	}
+
+	toHead, toBody := app.Resources()
+
+	rc.Markup = app.document(rc.Views, cloneMarkups(toHead), cloneMarkups(toBody), func(view *NView) *trees.Markup {
+		return rc.trees[view]
+	})
+
+	return &rc, nil
+}
+
+// Load loads the Loadable views and components of the views matching the
+// provided route concurrently, blocking until all of them are loaded. This
+// allows the content of an AsyncView to be rendered on the server by calling
+// Load before RenderFor. It returns the error of the context if it is done
+// before loading completes.
+func (app *NApp) Load(ctx context.Context, pe router.PushEvent) error {
+	var loadables []Loadable
+
+	for _, view := range app.Views() {
+		if _, _, ok := view.router.Test(pe.Rem); !ok {
+			continue
+		}
+
+		if loadable, ok := view.base.(Loadable); ok {
+			loadables = append(loadables, loadable)
+		}
+
+		view.children.walk(func(component *Component) {
+			if loadable, ok := component.Rendering.(Loadable); ok {
+				loadables = append(loadables, loadable)
+			}
+		})
+	}
+
+	errs := make(chan error, len(loadables))
+	for _, loadable := range loadables {
+		go func(loadable Loadable) {
+			errs <- loadable.Load(ctx)
+		}(loadable)
+	}
+
+	var failed error
+	for range loadables {
+		if err := <-errs; err != nil && failed == nil {
+			failed = err
+		}
+	}
+
+	return failed
+}
+
+// document returns the html tree of the app containing the provided views and
+// resources, where each view is rendered using the provided function.
+func (app *NApp) document(views []*NView, toHead []*trees.Markup, toBody []*trees.Markup, render func(*NView) *trees.Markup) *trees.Markup {
 	var html = trees.NewMarkup("html", false)
 	var head = trees.NewMarkup("head", false)
 
+	if variant := app.ThemeVariant(); variant != "" {
+		trees.NewAttr(common.ThemeVariantAttr, variant).Apply(html)
+	}
+
+	if locale := app.Locale(); locale != "" {
+		trees.NewAttr("lang", locale).Apply(html)
+		trees.NewAttr("dir", string(css.DirectionFor(locale))).Apply(html)
+	}
+
 	var body = trees.NewMarkup("body", false)
 	trees.NewAttr("gu-app-id", app.uuid).Apply(body)
 
@@ -192,27 +383,22 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 	body.Apply(html)
 
 	// Generate the resources according to the received data.
-	toHead, toBody := app.Resources()
 	head.AddChild(toHead...)
 
 	var last = elems.Div()
 
-	for _, view := range app.activeViews {
+	for _, view := range views {
 		switch view.target {
 		case HeadTarget:
-			view.Render().Apply(head)
+			render(view).Apply(head)
 		case BodyTarget:
-			view.Render().Apply(body)
+			render(view).Apply(body)
 		case AfterBodyTarget:
-			view.Render().Apply(last)
+			render(view).Apply(last)
 		}
 	}
 
-	script := trees.NewMarkup("script", false)
-	trees.NewAttr("type", "text/javascript").Apply(script)
-	trees.NewText(core.JavascriptDriverCore).Apply(script)
-
-	script.Apply(last)
+	app.coreScript().Apply(last)
 
 	last.ApplyChildren(body)
 
@@ -221,12 +407,50 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 	return html
 }
 
+// cloneMarkups returns copies of the provided markups.
+func cloneMarkups(markups []*trees.Markup) []*trees.Markup {
+	var clones []*trees.Markup
+	for _, markup := range markups {
+		clones = append(clones, markup.Clone())
+	}
+
+	return clones
+}
+
+// RenderContext holds the state of a single render of an NApp created by
+// NApp.RenderFor, keeping it apart from the app.
+type RenderContext struct {
+	Context  context.Context
+	Location router.PushEvent
+	Views    []*NView
+	Markup   *trees.Markup
+
+	trees map[*NView]*trees.Markup
+}
+
+// Matched returns true/false if any view matched the route of the render.
+func (r *RenderContext) Matched() bool {
+	return len(r.Views) != 0
+}
+
+// ViewMarkup returns the markup rendered for the provided view if it was
+// matched by the route of the render.
+func (r *RenderContext) ViewMarkup(view *NView) (*trees.Markup, bool) {
+	markup, ok := r.trees[view]
+	return markup, ok
+}
+
+// ActiveViews returns the views which matched the route last activated.
+func (app *NApp) ActiveViews() []*NView {
+	return app.activeViews
+}
+
 // PushViews returns a slice of  views that match and pass the provided path.
 func (app *NApp) PushViews(event router.PushEvent) []*NView {
 	// fmt.Printf("Routing Path: %s\n", event.Rem)
 	var active []*NView
 
-	for _, view := range app.views {
+	for _, view := range app.Views() {
 		if _, _, ok := view.router.Test(event.Rem); !ok {
 			// Notify view to appropriate proper action when view does not match.
 			view.router.Resolve(event)
@@ -243,6 +467,11 @@ func (app *NApp) PushViews(event router.PushEvent) []*NView {
 // AddAsset adds giving tree.Markup has assets to be loaded either in the head
 // or body based on the posiiton desired.
 func (app *NApp) AddAsset(asset *trees.Markup, target ViewTarget) {
+	app.stampResource(asset)
+
+	app.ml.Lock()
+	defer app.ml.Unlock()
+
 	switch target {
 	case HeadTarget:
 		app.resourceHeader = append(app.resourceHeader, asset)
@@ -253,17 +482,97 @@ func (app *NApp) AddAsset(asset *trees.Markup, target ViewTarget) {
 	}
 }
 
+// stampResource stamps the resource with a key of the app, so resources have
+// the same uids for apps with the same seed.
+func (app *NApp) stampResource(resource *trees.Markup) {
+	resource.SwapUID(app.keys.Key(app.uuid + "-resources"))
+	resource.Stamp()
+}
+
+// coreScript returns the script markup containing the javascript driver core.
+func (app *NApp) coreScript() *trees.Markup {
+	script := trees.NewMarkup("script", false)
+	trees.NewAttr("type", "text/javascript").Apply(script)
+	trees.NewText(core.JavascriptDriverCore).Apply(script)
+
+	script.SwapUID(app.uuid + "-core")
+	script.Stamp()
+
+	return script
+}
+
 // Resources return the giving resource headers which relate with the
 // view.
 func (app *NApp) Resources() ([]*trees.Markup, []*trees.Markup) {
+	app.ml.RLock()
+	defer app.ml.RUnlock()
+
 	return app.resourceHeader, app.resourceBody
 }
 
+// Views returns the views added to the app.
+func (app *NApp) Views() []*NView {
+	app.ml.RLock()
+	defer app.ml.RUnlock()
+
+	return app.views
+}
+
 // UUID returns the uuid specific to the giving view.
 func (app *NApp) UUID() string {
 	return app.uuid
 }
 
+// Deliver delivers the event to the handlers of the matching events within the
+// trees last rendered for the active views of the app, returning false if none
+// matched. Unlike notifications.Dispatch, which reaches the handlers of every
+// app in the process, it only reaches the app, so drivers hosting many
+// instances of an app with the same seed deliver events to the right one.
+func (app *NApp) Deliver(event common.EventBroadcast) bool {
+	var handlers []func(common.EventBroadcast)
+
+	for _, view := range app.activeViews {
+		if view.live == nil {
+			continue
+		}
+
+		view.live.EachEvent(func(ev *trees.Event, _ *trees.Markup) {
+			if ev.Handler != nil && ev.ID() == event.EventID {
+				handlers = append(handlers, ev.Handler)
+			}
+		})
+	}
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+
+	return len(handlers) > 0
+}
+
+// Seed returns the seed the keys of the app are derived from.
+func (app *NApp) Seed() string {
+	return app.seed
+}
+
+// Scheduler returns the Scheduler which schedules the updates of the views and
+// components of the app, flushing every FrameInterval by default.
+func (app *NApp) Scheduler() *Scheduler {
+	return app.scheduler
+}
+
+// Values returns the scope of values the app provides to the components of its
+// views, which is the parent of the scope of each view.
+func (app *NApp) Values() *Values {
+	return app.values
+}
+
+// Keys returns the KeyGenerator of the app, which can be used to derive keys
+// for types within the app.
+func (app *NApp) Keys() *KeyGenerator {
+	return app.keys
+}
+
 // ViewTarget defines a concrete type to define where the view should be rendered.
 type ViewTarget int
 
@@ -290,38 +599,34 @@ func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *
 		route = "*"
 	}
 
-	var base Renderable
-
-	switch rnb := renderable.(type) {
-	case Renderable:
-		base = rnb
-		break
-	case *trees.Markup:
-		base = Static(rnb)
-		break
-	case trees.Appliable:
-		base = ApplyStatic(rnb)
-		break
-	default:
-		panic("Only Renderable/trees.Markup allowed")
-	}
+	// Values which are not renderable fail to render, falling back within
+	// the error boundary instead of panicking.
+	base := mustRenderable(renderable)
 
 	var vw NView
 	vw.root = app
 	vw.target = target
 	vw.base = base
-	vw.uuid = NewKey()
+	vw.uuid = app.keys.Key(app.uuid)
 	vw.appUUID = app.uuid
 	vw.Reactive = NewReactive()
+	vw.mounted = NewSubscriptions()
+	vw.rendered = NewSubscriptions()
+	vw.updated = NewSubscriptions()
+	vw.unmounted = NewSubscriptions()
+	vw.values = NewValues(app.values)
 
 	vw.router = router.NewResolver(route)
 
-	// app.driver.Update(app, &vw)
+	// if the base can push reactions then listen, so the view re-renders when
+	// its base changes.
+	if rr, ok := base.(Reactor); ok {
+		rr.React(vw.Reactive.Publish)
+	}
+
+	// Schedule an update of the view for the next frame when it changes.
 	vw.React(func() {
-		notifications.Dispatch(ViewUpdate{
-			App:  app,
-			View: &vw,
-		})
+		app.scheduler.View(&vw)
 	})
 
 	// Register to listen for failure of route to match and
@@ -331,7 +636,9 @@ func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *
 		vw.Unmounted()
 	})
 
+	app.ml.Lock()
 	app.views = append(app.views, &vw)
+	app.ml.Unlock()
 
 	return &vw
 }
@@ -362,9 +669,15 @@ type NView struct {
 	updated   Subscriptions
 	unmounted Subscriptions
 
-	beginComponents []*Component
-	anyComponents   []*Component
-	lastComponents  []*Component
+	children components
+	boundary boundary
+	values   *Values
+
+	// live holds the tree of the last render of the view.
+	live *trees.Markup
+
+	// attached is true while the view is mounted.
+	attached bool
 }
 
 // UUID returns the uuid specific to the giving view.
@@ -384,7 +697,7 @@ func (v *NView) Do(viewFun func(*NView)) *NView {
 
 // totalComponents returns the total component list.
 func (v *NView) totalComponents() int {
-	return len(v.beginComponents) + len(v.anyComponents) + len(v.lastComponents)
+	return v.children.total()
 }
 
 // ViewJSON defines a struct which holds the giving sets of view changes to be
@@ -412,57 +725,219 @@ func (v *NView) Target() ViewTarget {
 
 // Render returns the markup for the giving views.
 func (v *NView) Render() *trees.Markup {
-	base := v.base.Render()
+	v.children.detach()
+	v.live = v.compose(v.renderBase(context.Background()), (*Component).Render, true)
+	return v.live
+}
 
-	// Process the begin components and immediately add appropriately into base.
-	for _, component := range v.beginComponents {
-		if component.Target == "" {
-			component.Render().ApplyMorphers().Apply(base)
-			continue
+// renderScoped returns the markup for the view built from copies of the trees
+// of its base and components rendered within the context, without reconciling
+// against or replacing the live trees of its components.
+func (v *NView) renderScoped(ctx context.Context) *trees.Markup {
+	return v.compose(v.children.clone(v.renderBase(ctx)), func(component *Component) *trees.Markup {
+		return component.renderScoped(ctx)
+	}, false)
+}
+
+// renderBase returns the markup of the base of the view rendered within the
+// context and its error boundary.
+func (v *NView) renderBase(ctx context.Context) *trees.Markup {
+	return v.boundary.render(ctx, v.uuid, v.base, v.children.trees(), func(err error, stack []byte) {
+		reportError(v, nil, err, stack)
+	})
+}
+
+// UseFallback sets the renderable rendered in place of the base of the view
+// when rendering it panics, where the components of the view are still
+// rendered into the fallback. The failure is reported through a RenderError
+// notification.
+func (v *NView) UseFallback(renderable interface{}) {
+	v.boundary.fallback = mustRenderable(renderable)
+}
+
+// compose adds the markup of the view's components into the base, using the
+// provided function to render each component. The trees added are recorded to
+// be detached by the next render if record is true.
+func (v *NView) compose(base *trees.Markup, render func(*Component) *trees.Markup, record bool) *trees.Markup {
+	// Set the writing direction of the app's locale on the view, so stylesheets
+	// within it are rendered for the direction.
+	if v.root != nil {
+		if locale := v.root.Locale(); locale != "" {
+			if _, err := trees.GetAttr(base, "dir"); err != nil {
+				trees.NewAttr("dir", string(css.DirectionFor(locale))).Apply(base)
+			}
 		}
+	}
 
-		render := component.Render().ApplyMorphers()
-		targets := trees.Query.QueryAll(base, component.Target)
-		for _, target := range targets {
-			target.AddChild(render)
-			target.UpdateHash()
+	v.children.compose(base, render, record)
+
+	// Stamp the uids and hashes of the view from its position and content, so
+	// html rendered on the server can be adopted by the client.
+	base.SwapUID(v.uuid)
+	base.Stamp()
+
+	return base
+}
+
+// composedTree defines a tree of a component added into a parent within the
+// markup of the view or component owning the component.
+type composedTree struct {
+	parent *trees.Markup
+	tree   *trees.Markup
+}
+
+// components defines the components owned by a view or a component in their
+// rendering orders, along with the trees of the components added into the
+// markup of their owner by its last render.
+type components struct {
+	begin []*Component
+	any   []*Component
+	last  []*Component
+
+	composed []composedTree
+}
+
+// add adds the component into the list of the rendering order.
+func (cs *components) add(component *Component, order RenderingOrder) {
+	switch order {
+	case FirstOrder:
+		cs.begin = append(cs.begin, component)
+	case LastOrder:
+		cs.last = append(cs.last, component)
+	case AnyOrder:
+		cs.any = append(cs.any, component)
+	}
+}
+
+// each calls the function for the components in their rendering order.
+func (cs *components) each(fn func(*Component)) {
+	for _, list := range [][]*Component{cs.begin, cs.any, cs.last} {
+		for _, component := range list {
+			fn(component)
 		}
 	}
+}
+
+// walk calls the function for the components and their sub-components in
+// their rendering order.
+func (cs *components) walk(fn func(*Component)) {
+	cs.each(func(component *Component) {
+		fn(component)
+		component.children.walk(fn)
+	})
+}
+
+// total returns the total number of components.
+func (cs *components) total() int {
+	return len(cs.begin) + len(cs.any) + len(cs.last)
+}
+
+// compose adds the markup of the components into the base, using the provided
+// function to render each component. The trees added are recorded to be
+// detached by the next render if record is true.
+func (cs *components) compose(base *trees.Markup, render func(*Component) *trees.Markup, record bool) {
+	cs.each(func(component *Component) {
+		markup := render(component).ApplyMorphers()
 
-	// Process the middle components and immediately add appropriately into base.
-	for _, component := range v.anyComponents {
 		if component.Target == "" {
-			component.Render().ApplyMorphers().Apply(base)
-			continue
+			cs.place(base, markup, record)
+			return
 		}
 
-		render := component.Render().ApplyMorphers()
 		targets := trees.Query.QueryAll(base, component.Target)
 		for _, target := range targets {
-			target.AddChild(render)
+			cs.place(target, markup, record)
 			target.UpdateHash()
 		}
+	})
+}
+
+// place adds the tree of a component into the parent, recording it if record
+// is true.
+func (cs *components) place(parent *trees.Markup, tree *trees.Markup, record bool) {
+	parent.AddChild(tree)
+
+	if record {
+		cs.composed = append(cs.composed, composedTree{parent: parent, tree: tree})
 	}
+}
 
-	// Process the last components and immediately add appropriately into base.
-	for _, component := range v.lastComponents {
-		if component.Target == "" {
-			component.Render().ApplyMorphers().Apply(base)
-			continue
+// detach removes the trees of components added by the last render from their
+// parents, so they are not rendered again alongside the new trees of the
+// components.
+func (cs *components) detach() {
+	for _, composed := range cs.composed {
+		composed.parent.RemoveChild(composed.tree)
+	}
+
+	cs.composed = nil
+}
+
+// clone returns a copy of the markup without the trees of components added by
+// the last render, leaving the markup untouched.
+func (cs *components) clone(markup *trees.Markup) *trees.Markup {
+	clone := markup.Clone()
+
+	if len(cs.composed) == 0 {
+		return clone
+	}
+
+	clones := make(map[*trees.Markup]*trees.Markup)
+
+	var pair func(original *trees.Markup, copied *trees.Markup)
+	pair = func(original *trees.Markup, copied *trees.Markup) {
+		clones[original] = copied
+
+		originals, copies := original.Children(), copied.Children()
+		for index := 0; index < len(originals) && index < len(copies); index++ {
+			pair(originals[index], copies[index])
 		}
+	}
 
-		render := component.Render().ApplyMorphers()
-		targets := trees.Query.QueryAll(base, component.Target)
-		for _, target := range targets {
-			target.AddChild(render)
-			target.UpdateHash()
+	pair(markup, clone)
+
+	for _, composed := range cs.composed {
+		if parent, ok := clones[composed.parent]; ok {
+			parent.RemoveChild(clones[composed.tree])
 		}
 	}
 
-	base.SwapUID(v.uuid)
-	base.UpdateHash()
+	return clone
+}
 
-	return base
+// trees returns the trees of the components added by the last render.
+func (cs *components) trees() []*trees.Markup {
+	var composed []*trees.Markup
+
+	for _, item := range cs.composed {
+		composed = append(composed, item.tree)
+	}
+
+	return composed
+}
+
+// contains returns true/false if the tree was added by the last render.
+func (cs *components) contains(tree *trees.Markup) bool {
+	for _, composed := range cs.composed {
+		if composed.tree == tree {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replace replaces the tree added by the last render with the new tree within
+// its parents.
+func (cs *components) replace(tree *trees.Markup, newTree *trees.Markup) {
+	for index, composed := range cs.composed {
+		if composed.tree != tree {
+			continue
+		}
+
+		composed.parent.ReplaceChild(tree, newTree)
+		cs.composed[index].tree = newTree
+	}
 }
 
 // propagateRoute supplies the needed route into the provided
@@ -470,14 +945,31 @@ func (v *NView) propagateRoute(pe router.PushEvent) {
 	v.router.Resolve(pe)
 }
 
-// Unmounted publishes changes notifications that the view is unmounted.
+// Unmounted publishes changes notifications that the view is unmounted and
+// unmounts its mounted components, notifying its base if it is Unmountable
+// and the view was mounted.
 func (v *NView) Unmounted() {
+	attached := v.attached
+
+	v.attached = false
+	v.children.each((*Component).Unmounted)
 	v.unmounted.Publish()
+
+	if unmountable, ok := v.base.(Unmountable); ok && attached {
+		unmountable.Unmounted()
+	}
 }
 
-// Updated publishes changes notifications that the view is updated.
+// Updated publishes changes notifications that the view is updated and
+// notifies its base if it is Updatable and its mounted components.
 func (v *NView) Updated() {
 	v.updated.Publish()
+
+	if updatable, ok := v.base.(Updatable); ok && v.attached {
+		updatable.Updated()
+	}
+
+	v.children.each((*Component).Updated)
 }
 
 // Rendered publishes changes notifications that the view is rendered.
@@ -485,9 +977,17 @@ func (v *NView) Rendered() {
 	v.rendered.Publish()
 }
 
-// Mounted publishes changes notifications that the view is mounted.
+// Mounted publishes changes notifications that the view is mounted, notifies
+// its base if it is Mountable and mounts its components whose routes matched.
 func (v *NView) Mounted() {
+	v.attached = true
 	v.mounted.Publish()
+
+	if mountable, ok := v.base.(Mountable); ok {
+		mountable.Mounted()
+	}
+
+	v.children.each((*Component).Mounted)
 }
 
 // RenderingOrder defines a type used to define the order which rendering is to be done for a resource.
@@ -516,57 +1016,92 @@ func (v *NView) Services() Services {
 		Unmounted: v.unmounted,
 		Updated:   v.updated,
 		Rendered:  v.rendered,
+		Values:    v.values,
 	}
 }
 
-// Component adds the provided component into the selected view.
-func (v *NView) Component(renderable interface{}, order RenderingOrder, route string, target string) {
-	var base Renderable
+// Values returns the scope of values the view provides to its components,
+// whose values override those of the app.
+func (v *NView) Values() *Values {
+	return v.values
+}
 
-	switch rnb := renderable.(type) {
-	case Renderable:
-		base = rnb
-		break
-	case *trees.Markup:
-		base = Static(rnb)
-		break
-	case trees.Appliable:
-		base = ApplyStatic(rnb)
-		break
-	default:
-		panic("Only Renderable/trees.Markup allowed")
-	}
+// Component adds the provided component into the selected view, returning the
+// component to allow adding sub-components into it.
+func (v *NView) Component(renderable interface{}, order RenderingOrder, route string, target string) *Component {
+	return v.component(nil, renderable, order, route, target)
+}
+
+// component adds the provided component into the view or into the parent
+// component if provided.
+func (v *NView) component(parent *Component, renderable interface{}, order RenderingOrder, route string, target string) *Component {
+	base := mustRenderable(renderable)
 
 	var c Component
-	c.uuid = NewKey()
+	c.view = v
+	c.parent = parent
 	c.Target = target
 	c.Rendering = base
 	c.Reactive = NewReactive()
 	c.Router = router.NewResolver(route)
+	c.mounted = NewSubscriptions()
+	c.updated = NewSubscriptions()
+	c.unmounted = NewSubscriptions()
+
+	if parent != nil {
+		c.uuid = v.root.keys.Key(parent.uuid)
+	} else {
+		c.uuid = v.root.keys.Key(v.uuid)
+	}
+
+	// Track the route state of the component, mounting it when its route
+	// matches while its owner is already mounted and unmounting it alone when
+	// its route fails to match.
+	c.Router.Done(func(push router.PushEvent) {
+		c.routed = true
+
+		if c.ownerAttached() {
+			c.Mounted()
+		}
+	})
+
+	c.Router.Failed(func(push router.PushEvent) {
+		c.routed = false
+		c.Unmounted()
+	})
 
 	// if the renderable can push reactions then listen.
 	if rr, ok := base.(Reactor); ok {
 		rr.React(c.Reactive.Publish)
 	}
 
-	// Connect the view to react to a change from the component.
-	c.React(v.Publish)
+	// Schedule an update of only the component for the next frame when it
+	// changes.
+	c.React(func() {
+		v.root.scheduler.Component(&c)
+	})
 
-	// Register the component router into the views router.
-	v.router.Register(c.Router)
+	// Register the component router into the router of its owner and add the
+	// component into the right order.
+	if parent != nil {
+		parent.Router.Register(c.Router)
+		parent.children.add(&c, order)
+	} else {
+		v.router.Register(c.Router)
+		v.children.add(&c, order)
+	}
 
-	// format for the object.
-	// Add the component into the right order.
-	{
-		switch order {
-		case FirstOrder:
-			v.beginComponents = append(v.beginComponents, &c)
-		case LastOrder:
-			v.lastComponents = append(v.lastComponents, &c)
-		case AnyOrder:
-			v.anyComponents = append(v.anyComponents, &c)
-		}
+	// Supply the services of the component if the renderable wants them.
+	if receiver, ok := base.(ServicesReceiver); ok {
+		receiver.UseServices(c.Services())
+	}
+
+	// Let the renderable declare its sub-components.
+	if composite, ok := base.(Composite); ok {
+		composite.Compose(&c)
 	}
+
+	return &c
 }
 
 // Component defines a struct which
@@ -578,7 +1113,20 @@ type Component struct {
 	Rendering Renderable
 	Router    router.Resolver
 
-	live *trees.Markup
+	view     *NView
+	parent   *Component
+	children components
+	boundary boundary
+	live     *trees.Markup
+
+	// routed is true while the route of the component matches and attached
+	// is true while the component is mounted.
+	routed   bool
+	attached bool
+
+	mounted   Subscriptions
+	updated   Subscriptions
+	unmounted Subscriptions
 }
 
 // UUID returns the identification for the giving component.
@@ -586,12 +1134,123 @@ func (c Component) UUID() string {
 	return c.uuid
 }
 
-// Render returns the markup corresponding to the internal Renderable.
+// Component adds the provided component as a sub-component of the component,
+// where it is rendered into the markup of the component either appended or
+// into the elements matching the target selector. The route of the
+// sub-component is matched against the path left by the route of the
+// component. It returns the sub-component to allow nesting further.
+func (c *Component) Component(renderable interface{}, order RenderingOrder, route string, target string) *Component {
+	return c.view.component(c, renderable, order, route, target)
+}
+
+// Services returns the Services of the view of the component, where the route
+// and the mounted, unmounted and updated subscriptions are those of the
+// component.
+func (c *Component) Services() Services {
+	services := c.view.Services()
+	services.ViewRoute = c.Router
+	services.Mounted = c.mounted
+	services.Updated = c.updated
+	services.Unmounted = c.unmounted
+
+	return services
+}
+
+// Mounted mounts the component if its route matched and it is not mounted,
+// notifying its subscribers and its Renderable if it is Mountable, then mounts
+// its sub-components.
+func (c *Component) Mounted() {
+	if c.attached || !c.routed {
+		return
+	}
+
+	c.attached = true
+	c.mounted.Publish()
+
+	if mountable, ok := c.Rendering.(Mountable); ok {
+		mountable.Mounted()
+	}
+
+	c.children.each((*Component).Mounted)
+}
+
+// Unmounted unmounts the sub-components of the component and then the
+// component if it is mounted, notifying its subscribers and its Renderable if
+// it is Unmountable.
+func (c *Component) Unmounted() {
+	if !c.attached {
+		return
+	}
+
+	c.children.each((*Component).Unmounted)
+
+	c.attached = false
+	c.unmounted.Publish()
+
+	if unmountable, ok := c.Rendering.(Unmountable); ok {
+		unmountable.Unmounted()
+	}
+}
+
+// Updated notifies the subscribers of the component and its Renderable if it
+// is Updatable that it is updated, if the component is mounted, then notifies
+// its sub-components.
+func (c *Component) Updated() {
+	if !c.attached {
+		return
+	}
+
+	c.updated.Publish()
+
+	if updatable, ok := c.Rendering.(Updatable); ok {
+		updatable.Updated()
+	}
+
+	c.children.each((*Component).Updated)
+}
+
+// ownerAttached returns true/false if the view or component owning the
+// component is mounted.
+func (c *Component) ownerAttached() bool {
+	if c.parent != nil {
+		return c.parent.attached
+	}
+
+	return c.view.attached
+}
+
+// parentDirty returns true/false if a parent component of the component is
+// within the dirty set.
+func (c *Component) parentDirty(dirty map[interface{}]bool) bool {
+	for parent := c.parent; parent != nil; parent = parent.parent {
+		if dirty[parent] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// owner returns the components of the view or component owning the component.
+func (c *Component) owner() *components {
+	if c.parent != nil {
+		return &c.parent.children
+	}
+
+	return &c.view.children
+}
+
+// Render returns the markup corresponding to the internal Renderable, with
+// the markup of its sub-components added. The markup is reconciled against
+// the last markup of the component, while each sub-component reconciles
+// against its own last markup.
 func (c *Component) Render() *trees.Markup {
-	newTree := c.Rendering.Render()
+	c.children.detach()
+
+	newTree := c.renderRendering(context.Background())
 	newTree.SwapUID(c.uuid)
 
-	if c.live != nil {
+	if c.live != nil && c.live != newTree {
 		live := c.live
 		live.EachEvent(func(e *trees.Event, _ *trees.Markup) {
 			if e.Remove != nil {
@@ -604,10 +1263,73 @@ func (c *Component) Render() *trees.Markup {
 	}
 
 	c.live = newTree.ApplyMorphers()
+	c.children.compose(c.live, (*Component).Render, true)
 
 	return c.live
 }
 
+// renderScoped returns a copy of the markup of the internal Renderable and its
+// sub-components rendered within the context, without reconciling against or
+// replacing the live trees of the components.
+func (c *Component) renderScoped(ctx context.Context) *trees.Markup {
+	newTree := c.children.clone(c.renderRendering(ctx))
+	newTree.SwapUID(c.uuid)
+	newTree.ApplyMorphers()
+
+	c.children.compose(newTree, func(component *Component) *trees.Markup {
+		return component.renderScoped(ctx)
+	}, false)
+
+	return newTree
+}
+
+// renderRendering returns the markup of the internal Renderable rendered
+// within the context and the error boundary of the component.
+func (c *Component) renderRendering(ctx context.Context) *trees.Markup {
+	return c.boundary.render(ctx, c.uuid, c.Rendering, c.children.trees(), func(err error, stack []byte) {
+		reportError(c.view, c, err, stack)
+	})
+}
+
+// UseFallback sets the renderable rendered in place of the internal Renderable
+// of the component when rendering it panics, so the rest of the view still
+// renders. The failure is reported through a RenderError notification.
+func (c *Component) UseFallback(renderable interface{}) {
+	c.boundary.fallback = mustRenderable(renderable)
+}
+
+// placed returns true/false if the live tree of the component is within the
+// last render of its view.
+func (c *Component) placed() bool {
+	if c.live == nil || !c.owner().contains(c.live) {
+		return false
+	}
+
+	return c.parent == nil || c.parent.placed()
+}
+
+// rerender renders the component and its sub-components again in place of its
+// live tree within the last render of its view, keeping the uid of the tree
+// and stamping its children as a render of the whole view would. It returns
+// false without rendering if the component is not within the last render of
+// its view.
+func (c *Component) rerender() (*trees.Markup, bool) {
+	if !c.placed() {
+		return nil, false
+	}
+
+	live := c.live
+	uid := live.UID()
+
+	newTree := c.Render()
+	c.owner().replace(live, newTree)
+
+	newTree.SwapUID(uid)
+	newTree.Stamp()
+
+	return newTree, true
+}
+
 // Disabled returns true/false if the giving view is disabled.
 func (v *NView) Disabled() bool {
 	return v.active
@@ -633,7 +1355,6 @@ type ApplyView struct {
 	Mounted  Subscriptions
 	Rendered Subscriptions
 	Content  trees.Appliable
-	base     *trees.Markup
 }
 
 // ApplyStatic defines a toplevel function which returns a new instance of a StaticView using the
@@ -642,7 +1363,6 @@ func ApplyStatic(tree trees.Appliable) *ApplyView {
 	return &ApplyView{
 		Content: tree,
 		uid:     NewKey(),
-		base:    trees.NewMarkup("div", false),
 	}
 }
 
@@ -651,17 +1371,19 @@ func (a *ApplyView) UUID() string {
 	return a.uid
 }
 
-// Render returns the markup for the static view.
+// Render returns the markup for the static view. The content is applied into a
+// new base on each render, so the view can be rendered concurrently.
 func (a *ApplyView) Render() *trees.Markup {
-	a.Content.Apply(a.base)
+	base := trees.NewMarkup("div", false)
+	a.Content.Apply(base)
 
-	children := a.base.Children()
+	children := base.Children()
 
 	if len(children) == 0 {
-		return a.base
+		return base
 	}
 
-	a.base.Empty()
+	base.Empty()
 
 	root := children[0]
 	if a.Morph {
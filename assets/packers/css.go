@@ -4,20 +4,30 @@ package packers
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 
 	"github.com/gu-io/gu/assets"
+	"github.com/gu-io/gu/trees/css"
 )
 
 // CSSPacker defines an implementation for parsing css files.
+// If a Processor is provided, it is used to prefix, merge and minify the css
+// files in Go instead of using CleanCSS.
 type CSSPacker struct {
-	CleanCSS bool
+	CleanCSS  bool
+	Processor *css.Processor
 }
 
 // Pack process all files present in the FileStatment slice and returns WriteDirectives
 // which contains expected outputs for these files.
 func (csp CSSPacker) Pack(statements []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	if csp.Processor != nil {
+		return (ProcessedCSSPacker{Processor: csp.Processor}).Pack(statements, dir)
+	}
+
 	if csp.CleanCSS {
 		return (CleanCSSPacker{Args: []string{"-O", "1"}}).Pack(statements, dir)
 	}
@@ -44,3 +54,41 @@ func (csp CSSPacker) Pack(statements []assets.FileStatement, dir assets.DirState
 
 	return directives, nil
 }
+
+// ProcessedCSSPacker defines an implementation for parsing css files which runs
+// them through the provided css.Processor, adding vendor prefixes, merging rules
+// and minifying the files without the need for Nodejs.
+type ProcessedCSSPacker struct {
+	Processor *css.Processor
+}
+
+// Pack process all files present in the FileStatment slice and returns WriteDirectives
+// which contains expected outputs for these files.
+func (pcp ProcessedCSSPacker) Pack(statements []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	processor := pcp.Processor
+	if processor == nil {
+		processor = css.NewProcessor()
+	}
+
+	var directives []assets.WriteDirective
+
+	for _, statement := range statements {
+		content, err := ioutil.ReadFile(statement.AbsPath)
+		if err != nil {
+			return nil, err
+		}
+
+		sheet, err := css.Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse css file %q: %s", statement.Path, err.Error())
+		}
+
+		directives = append(directives, assets.WriteDirective{
+			Writer:        bytes.NewBufferString(processor.Print(sheet)),
+			OriginPath:    statement.Path,
+			OriginAbsPath: statement.AbsPath,
+		})
+	}
+
+	return directives, nil
+}
@@ -0,0 +1,86 @@
+package packers_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/gu-io/gu/assets"
+	"github.com/gu-io/gu/assets/packers"
+	"github.com/gu-io/gu/trees/css"
+	"github.com/influx6/faux/tests"
+)
+
+func TestProcessedCSSPacker(t *testing.T) {
+	expected := "html{width:100%;height:100%}body{width:100%;height:100%}div.tuglife{width:100%;height:100%}"
+	fixtures := filepath.Join(thisSrc, "assets/packers/fixtures")
+	wordan := filepath.Join(fixtures, "wordan.css")
+	wordanRel := filepath.Join("./packers/fixtures/", "wordan.css")
+
+	processed := packers.ProcessedCSSPacker{Processor: css.NewProcessor()}
+
+	response, err := processed.Pack([]assets.FileStatement{{
+		Path:    wordanRel,
+		AbsPath: wordan,
+	}}, assets.DirStatement{})
+
+	if err != nil {
+		tests.Failed("Should have successfully packed css file: %+q", err)
+	}
+	tests.Passed("Should have successfully packed css file")
+
+	if len(response) != 1 {
+		tests.Failed("Should have successfully received processed css file")
+	}
+	tests.Passed("Should have successfully received processed css file")
+
+	var b bytes.Buffer
+	if _, err := response[0].Writer.WriteTo(&b); err != nil {
+		tests.Failed("Should have successfully written data to buffer: %+q", err)
+	}
+	tests.Passed("Should have successfully written data to buffer")
+
+	if b.String() != expected {
+		tests.Info("Expected: %+q", expected)
+		tests.Info("Received: %+q", b.String())
+		tests.Failed("Should have successfully matched css output with expected")
+	}
+	tests.Passed("Should have successfully matched css output with expected")
+}
+
+func TestProcessedCSSPackerContainer(t *testing.T) {
+	expected := ".card{container-type:inline-size}@container (min-width: 480px){.card p{margin:0}}"
+	fixtures := filepath.Join(thisSrc, "assets/packers/fixtures")
+	container := filepath.Join(fixtures, "container.css")
+	containerRel := filepath.Join("./packers/fixtures/", "container.css")
+
+	processed := packers.ProcessedCSSPacker{Processor: css.NewProcessor()}
+
+	response, err := processed.Pack([]assets.FileStatement{{
+		Path:    containerRel,
+		AbsPath: container,
+	}}, assets.DirStatement{})
+
+	if err != nil {
+		tests.Failed("Should have successfully packed css file with @container rule: %+q", err)
+	}
+	tests.Passed("Should have successfully packed css file with @container rule")
+
+	if len(response) != 1 {
+		tests.Failed("Should have successfully received processed css file")
+	}
+	tests.Passed("Should have successfully received processed css file")
+
+	var b bytes.Buffer
+	if _, err := response[0].Writer.WriteTo(&b); err != nil {
+		tests.Failed("Should have successfully written data to buffer: %+q", err)
+	}
+	tests.Passed("Should have successfully written data to buffer")
+
+	if b.String() != expected {
+		tests.Info("Expected: %+q", expected)
+		tests.Info("Received: %+q", b.String())
+		tests.Failed("Should have successfully matched css output with expected")
+	}
+	tests.Passed("Should have successfully matched css output with expected")
+}
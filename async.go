@@ -0,0 +1,340 @@
+package gu
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// AsyncLoader defines a function which loads the content of an AsyncView,
+// returning a Renderable, a *trees.Markup or a trees.Appliable. The context is
+// cancelled once the content is no longer wanted.
+type AsyncLoader func(context.Context) (interface{}, error)
+
+// AsyncView defines a Renderable whose content is loaded by an AsyncLoader,
+// rendering a placeholder while the content loads. It starts loading when its
+// view or component is mounted, cancels the loader when it is unmounted, e.g
+// once its route fails to match, and publishes a change once the content is
+// loaded so its view or component re-renders. Content loaded through Load while
+// it is not mounted, as NApp.Load does on the server, belongs to the context of
+// the load and is only rendered by renders within that context, unless the
+// context is never done.
+type AsyncView struct {
+	Reactive
+
+	loader      AsyncLoader
+	placeholder Renderable
+	failure     func(error) interface{}
+
+	ml       sync.Mutex
+	mounted  bool
+	loading  *asyncLoad
+	loaded   bool
+	content  Renderable
+	err      error
+	requests map[context.Context]*asyncLoad
+}
+
+// asyncLoad defines a run of the loader of an AsyncView, holding its content
+// once loaded for runs loading the content of a context.
+type asyncLoad struct {
+	cancel  context.CancelFunc
+	done    chan struct{}
+	loaded  bool
+	content Renderable
+	err     error
+}
+
+// Async returns a new AsyncView loading its content with the loader. The
+// placeholder is rendered while the content loads and the markup returned by
+// failure for the error of the loader is rendered if the loader fails. If no
+// failure function is provided the error is raised within the error boundary
+// of the component.
+func Async(loader AsyncLoader, placeholder interface{}, failure func(error) interface{}) *AsyncView {
+	async := AsyncView{
+		Reactive: NewReactive(),
+		loader:   loader,
+		failure:  failure,
+	}
+
+	if placeholder != nil {
+		async.placeholder = mustRenderable(placeholder)
+	}
+
+	return &async
+}
+
+// Render returns the markup of the loaded content, the placeholder while the
+// content loads or the failure markup if the loader failed.
+func (a *AsyncView) Render() *trees.Markup {
+	a.ml.Lock()
+	loaded, content, err := a.loaded, a.content, a.err
+	a.ml.Unlock()
+
+	return a.render(context.Background(), loaded, content, err)
+}
+
+// RenderFor implements the ContextRenderable interface, rendering the content
+// loaded through Load for the context, or as Render does if none was loaded
+// for the context.
+func (a *AsyncView) RenderFor(ctx context.Context) *trees.Markup {
+	a.ml.Lock()
+	load, ok := a.requests[ctx]
+	if !ok {
+		a.ml.Unlock()
+		return a.Render()
+	}
+
+	loaded, content, err := load.loaded, load.content, load.err
+	a.ml.Unlock()
+
+	return a.render(ctx, loaded, content, err)
+}
+
+// render returns the markup of the content rendered within the context, the
+// placeholder if the content is not loaded or the failure markup for the
+// error.
+func (a *AsyncView) render(ctx context.Context, loaded bool, content Renderable, err error) *trees.Markup {
+	if !loaded {
+		if a.placeholder == nil {
+			return trees.NewMarkup("div", false)
+		}
+
+		return renderWithin(ctx, a.placeholder)
+	}
+
+	if err == nil {
+		return renderWithin(ctx, content)
+	}
+
+	if a.failure == nil {
+		panic(err)
+	}
+
+	return renderWithin(ctx, mustRenderable(a.failure(err)))
+}
+
+// Mounted implements the Mountable interface, starting to load the content if
+// it is neither loaded nor loading.
+func (a *AsyncView) Mounted() {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	a.mounted = true
+
+	if !a.loaded && a.loading == nil {
+		a.start(context.Background())
+	}
+}
+
+// Unmounted implements the Unmountable interface, cancelling the loader if the
+// content is loading.
+func (a *AsyncView) Unmounted() {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	a.mounted = false
+	a.cancel()
+}
+
+// Load implements the Loadable interface, blocking until the content is
+// loaded or the context is done. While mounted it waits for the content
+// rendered by Render, starting to load it if it is neither loaded nor loading.
+// Otherwise the content is loaded for the context alone, to be rendered by
+// RenderFor within the context, and discarded once the context is done. A
+// context which is never done, e.g context.Background(), loads the content
+// rendered by Render instead, as its content would never be discarded. A load
+// started by Load is cancelled with its context.
+func (a *AsyncView) Load(ctx context.Context) error {
+	a.ml.Lock()
+	mounted := a.mounted
+	a.ml.Unlock()
+
+	if !mounted && ctx.Done() != nil {
+		return a.loadFor(ctx)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		a.ml.Lock()
+		if a.loaded {
+			a.ml.Unlock()
+			return nil
+		}
+
+		load := a.loading
+		if load == nil {
+			load = a.start(ctx)
+		}
+		a.ml.Unlock()
+
+		select {
+		case <-load.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// loadFor loads the content for the context unless it is loaded or loading,
+// blocking until the content is loaded or the context is done.
+func (a *AsyncView) loadFor(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	a.ml.Lock()
+	load, ok := a.requests[ctx]
+	if !ok {
+		load = a.request(ctx)
+	}
+	a.ml.Unlock()
+
+	select {
+	case <-load.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// request runs the loader for the context, keeping its content until the
+// context is done, which must not be nil. It must be called with the lock
+// held.
+func (a *AsyncView) request(parent context.Context) *asyncLoad {
+	ctx, cancel := context.WithCancel(parent)
+
+	load := &asyncLoad{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	if a.requests == nil {
+		a.requests = make(map[context.Context]*asyncLoad)
+	}
+
+	a.requests[parent] = load
+
+	go func() {
+		defer close(load.done)
+
+		content, err := a.call(ctx)
+
+		a.ml.Lock()
+		load.loaded, load.content, load.err = true, content, err
+		a.ml.Unlock()
+	}()
+
+	go func() {
+		<-parent.Done()
+
+		a.ml.Lock()
+		if a.requests[parent] == load {
+			delete(a.requests, parent)
+		}
+		a.ml.Unlock()
+
+		cancel()
+	}()
+
+	return load
+}
+
+// Reload discards the loaded content, including the content loaded for
+// contexts, cancelling the loaders if the content is loading, and loads the
+// content again if its view or component is mounted, e.g once the data it
+// depends on changes.
+func (a *AsyncView) Reload() {
+	a.ml.Lock()
+	a.cancel()
+	a.loaded, a.content, a.err = false, nil, nil
+
+	for ctx, load := range a.requests {
+		load.cancel()
+		delete(a.requests, ctx)
+	}
+
+	if a.mounted {
+		a.start(context.Background())
+	}
+	a.ml.Unlock()
+
+	a.Publish()
+}
+
+// start runs the loader within a context derived from the parent, returning
+// the run. It must be called with the lock held.
+func (a *AsyncView) start(parent context.Context) *asyncLoad {
+	ctx, cancel := context.WithCancel(parent)
+
+	load := &asyncLoad{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	a.loading = load
+
+	go a.run(ctx, load)
+
+	return load
+}
+
+// cancel cancels the running loader if any. It must be called with the lock
+// held.
+func (a *AsyncView) cancel() {
+	if a.loading == nil {
+		return
+	}
+
+	a.loading.cancel()
+	a.loading = nil
+}
+
+// run calls the loader and stores its content, publishing a change if the run
+// was not cancelled.
+func (a *AsyncView) run(ctx context.Context, load *asyncLoad) {
+	defer close(load.done)
+
+	content, err := a.call(ctx)
+
+	a.ml.Lock()
+
+	current := a.loading == load
+	if current {
+		a.loading = nil
+	}
+
+	if !current || ctx.Err() != nil {
+		a.ml.Unlock()
+		load.cancel()
+		return
+	}
+
+	a.loaded, a.content, a.err = true, content, err
+	a.ml.Unlock()
+
+	load.cancel()
+	a.Publish()
+}
+
+// call returns the Renderable loaded by the loader, recovering a panic of the
+// loader into an error.
+func (a *AsyncView) call(ctx context.Context) (content Renderable, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			content, err = nil, fmt.Errorf("Loader panicked: %v", recovered)
+		}
+	}()
+
+	value, err := a.loader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderableOf(value)
+}
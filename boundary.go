@@ -0,0 +1,152 @@
+package gu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/trees"
+)
+
+// RenderErrorAttr defines the attribute of the markup rendered in place of a
+// view or component whose rendering failed without a fallback, holding the
+// uuid of the view or component.
+const RenderErrorAttr = "gu-render-error"
+
+// renderableOf returns the Renderable for the value, which must be a
+// Renderable, a *trees.Markup or a trees.Appliable.
+func renderableOf(value interface{}) (Renderable, error) {
+	switch rnb := value.(type) {
+	case Renderable:
+		return rnb, nil
+	case *trees.Markup:
+		return Static(rnb), nil
+	case trees.Appliable:
+		return ApplyStatic(rnb), nil
+	}
+
+	return nil, fmt.Errorf("Only Renderable/trees.Markup allowed, received %T", value)
+}
+
+// mustRenderable returns the Renderable for the value, returning a Renderable
+// which fails every render with the error if the value is not renderable, so
+// the failure is reported by the error boundary of its view or component.
+func mustRenderable(value interface{}) Renderable {
+	renderable, err := renderableOf(value)
+	if err != nil {
+		return invalidRenderable{err: err}
+	}
+
+	return renderable
+}
+
+// invalidRenderable defines a Renderable standing in for a value which is not
+// renderable.
+type invalidRenderable struct {
+	err error
+}
+
+// Render panics with the error of the renderable.
+func (i invalidRenderable) Render() *trees.Markup {
+	panic(i.err)
+}
+
+// boundary defines the error boundary of a view or component, which renders
+// its Renderable and renders the fallback in its place if it panics. Failures
+// are reported through RenderError notifications.
+type boundary struct {
+	fallback Renderable
+}
+
+// render returns the markup of the renderable, reporting a panic or an error
+// within the markup through the report function. The fallback is rendered if
+// the renderable panics, or an empty element marked by RenderErrorAttr if no
+// fallback is set or the fallback panics too. The composed trees of the
+// components within the markup are left to their own boundaries.
+func (b *boundary) render(ctx context.Context, uuid string, renderable Renderable, composed []*trees.Markup, report func(error, []byte)) *trees.Markup {
+	markup, stack, err := tryRender(ctx, renderable)
+	if err == nil {
+		if err := markup.Validate(composed...); err != nil {
+			report(err, nil)
+		}
+
+		return markup
+	}
+
+	report(err, stack)
+
+	if b.fallback != nil {
+		fallback, stack, err := tryRender(ctx, b.fallback)
+		if err == nil {
+			return fallback
+		}
+
+		report(err, stack)
+	}
+
+	failed := trees.NewMarkup("div", false)
+	trees.NewAttr(RenderErrorAttr, uuid).Apply(failed)
+
+	return failed
+}
+
+// tryRender returns the markup of the renderable rendered within the context,
+// recovering a panic into an error along with its stack trace.
+func tryRender(ctx context.Context, renderable Renderable) (markup *trees.Markup, stack []byte, err error) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		markup = nil
+		stack = debug.Stack()
+
+		if rerr, ok := recovered.(error); ok {
+			err = rerr
+			return
+		}
+
+		err = fmt.Errorf("%v", recovered)
+	}()
+
+	markup = renderWithin(ctx, renderable)
+	if markup == nil {
+		return nil, nil, errors.New("Renderable returned no markup")
+	}
+
+	return markup, nil, nil
+}
+
+// renderWithin returns the markup of the renderable, rendering it within the
+// context if it is a ContextRenderable.
+func renderWithin(ctx context.Context, renderable Renderable) *trees.Markup {
+	if scoped, ok := renderable.(ContextRenderable); ok {
+		return scoped.RenderFor(ctx)
+	}
+
+	return renderable.Render()
+}
+
+// reportError dispatches a RenderError for the view or the component of the
+// view if provided.
+func reportError(view *NView, component *Component, err error, stack []byte) {
+	failure := RenderError{
+		App:        view.root,
+		View:       view,
+		Component:  component,
+		UUID:       view.uuid,
+		Renderable: fmt.Sprintf("%T", view.base),
+		Err:        err,
+		Stack:      stack,
+	}
+
+	if component != nil {
+		failure.UUID = component.uuid
+		failure.Renderable = fmt.Sprintf("%T", component.Rendering)
+	}
+
+	notifications.Dispatch(failure)
+}
@@ -85,4 +85,38 @@ type Theme struct {
 	AnimationCurveFastOutSlowIn   string
 	AnimationCurveLinearOutSlowIn string
 	MaterialPalettes              map[string][]string
+	Breakpoints                   map[string]int // Breakpoints maps names like tablet or desktop to their minimum width in pixels.
+	CustomProperties              bool           // CustomProperties renders colors as css custom properties with light, dark and high contrast variants.
+	MinimumContrast               float64        // MinimumContrast is the contrast ratio text colors must meet against their backgrounds, defaults to the WCAG AA ratio of 4.5.
+	Direction                     string         // Direction is the writing direction the styleguide is generated for, "rtl" flips its directional properties.
+}
+
+// contains the theme variants generated when a Theme uses CustomProperties and
+// the root attribute used to switch between them.
+const (
+	ThemeVariantAttr    = "data-theme"
+	LightVariant        = "light"
+	DarkVariant         = "dark"
+	HighContrastVariant = "high-contrast"
+)
+
+// DefaultBreakpoints defines the default set of named breakpoints used when a
+// Theme declares none.
+var DefaultBreakpoints = map[string]int{
+	"phone":         480,
+	"tablet":        768,
+	"desktop":       1024,
+	"desktop-large": 1440,
+}
+
+// Breakpoint returns the minimum width in pixels for the giving named breakpoint
+// using the DefaultBreakpoints when the theme has no breakpoints declared.
+func (t Theme) Breakpoint(name string) (int, bool) {
+	if len(t.Breakpoints) == 0 {
+		width, ok := DefaultBreakpoints[name]
+		return width, ok
+	}
+
+	width, ok := t.Breakpoints[name]
+	return width, ok
 }
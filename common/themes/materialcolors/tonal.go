@@ -0,0 +1,154 @@
+package materialcolors
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// contains values used in generating tonal palettes.
+const (
+	lightestTone = 0.96
+	darkestTone  = 0.22
+
+	minAnchorTone = 0.30
+	maxAnchorTone = 0.85
+
+	neutralChroma = 0.04
+	accentChroma  = 1.3
+	accentHueStep = 60
+)
+
+var (
+	// lightSteps defines how far each of the 50-400 grades is from the seed tone
+	// to the lightest tone.
+	lightSteps = []float64{1, 0.85, 0.65, 0.45, 0.22}
+
+	// darkSteps defines how far each of the 600-900 grades is from the seed tone
+	// to the darkest tone.
+	darkSteps = []float64{0.15, 0.35, 0.6, 0.85}
+
+	// accentTones defines the tones of the A100, A200, A400 and A700 grades.
+	accentTones = []float64{0.82, 0.70, 0.60, 0.50}
+)
+
+// Generate returns the palettes generated from the seed color keyed by the
+// provided name, which are the tonal palette under name, a neutral palette under
+// name-neutral and an accent palette with a shifted hue under name-accent. Each
+// palette follows the layout of MaterialPalettes, where the grades 50-900 are
+// followed by the A100, A200, A400 and A700 accents, so they can be added into
+// Theme.MaterialPalettes or registered for the css `materialColors` helper.
+// The seed can be a hex color or a "r,g,b" value.
+func Generate(name string, seed string) (map[string][]string, error) {
+	c, err := parseSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	hue, chroma, _ := c.Hcl()
+
+	name = strings.ToLower(name)
+
+	return map[string][]string{
+		name:              TonalPalette(c),
+		name + "-neutral": TonalPalette(colorful.Hcl(hue, math.Min(chroma, neutralChroma), tone(c))),
+		name + "-accent":  TonalPalette(colorful.Hcl(math.Mod(hue+accentHueStep, 360), chroma, tone(c))),
+	}, nil
+}
+
+// TonalPalette returns a perceptually uniform palette for the seed color, where
+// the grades are generated by moving the CIELAB lightness of the seed towards
+// white and black while keeping its hue and chroma within the rgb gamut.
+func TonalPalette(seed colorful.Color) []string {
+	hue, chroma, lightness := seed.Hcl()
+
+	anchor := math.Max(minAnchorTone, math.Min(maxAnchorTone, lightness))
+
+	var palette []string
+
+	for _, step := range lightSteps {
+		palette = append(palette, rgb(toneColor(hue, chroma, anchor+((lightestTone-anchor)*step))))
+	}
+
+	if anchor == lightness {
+		palette = append(palette, rgb(seed.Clamped()))
+	} else {
+		palette = append(palette, rgb(toneColor(hue, chroma, anchor)))
+	}
+
+	for _, step := range darkSteps {
+		palette = append(palette, rgb(toneColor(hue, chroma, anchor-((anchor-darkestTone)*step))))
+	}
+
+	for _, accent := range accentTones {
+		palette = append(palette, rgb(toneColor(hue, chroma*accentChroma, accent)))
+	}
+
+	return palette
+}
+
+// toneColor returns the color for the hue, chroma and lightness, reducing the
+// chroma until the color fits within the rgb gamut.
+func toneColor(hue, chroma, lightness float64) colorful.Color {
+	c := colorful.Hcl(hue, chroma, lightness)
+	if c.IsValid() {
+		return c
+	}
+
+	low, high := 0.0, chroma
+	for i := 0; i < 20; i++ {
+		mid := (low + high) / 2
+
+		if colorful.Hcl(hue, mid, lightness).IsValid() {
+			low = mid
+			continue
+		}
+
+		high = mid
+	}
+
+	return colorful.Hcl(hue, low, lightness).Clamped()
+}
+
+// tone returns the CIELAB lightness of the color.
+func tone(c colorful.Color) float64 {
+	_, _, l := c.Hcl()
+	return l
+}
+
+// rgb returns the color as a "r,g,b" value used by the palettes.
+func rgb(c colorful.Color) string {
+	r, g, b := c.RGB255()
+	return fmt.Sprintf("%d,%d,%d", r, g, b)
+}
+
+// parseSeed returns the color for the hex or "r,g,b" value.
+func parseSeed(seed string) (colorful.Color, error) {
+	seed = strings.TrimSpace(seed)
+
+	if strings.HasPrefix(seed, "#") {
+		return colorful.Hex(seed)
+	}
+
+	parts := strings.Split(seed, ",")
+	if len(parts) != 3 {
+		return colorful.Color{}, errors.New("Invalid seed color, expected hex or r,g,b value")
+	}
+
+	var values []float64
+
+	for _, part := range parts {
+		value, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || value < 0 || value > 255 {
+			return colorful.Color{}, errors.New("Invalid seed color, expected hex or r,g,b value")
+		}
+
+		values = append(values, float64(value)/255)
+	}
+
+	return colorful.Color{R: values[0], G: values[1], B: values[2]}, nil
+}
@@ -0,0 +1,193 @@
+package styleguide
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/gu-io/gu/common"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// contains the minimum contrast ratios defined by the WCAG 2.0 guidelines.
+const (
+	AAContrast      = 4.5
+	AALargeContrast = 3.0
+	AAAContrast     = 7.0
+)
+
+var (
+	black = Color{C: colorful.Color{R: 0, G: 0, B: 0}, Alpha: 1}
+	white = Color{C: colorful.Color{R: 1, G: 1, B: 1}, Luminosity: 1, Alpha: 1}
+)
+
+// ContrastWarnings defines the writer into which Render writes warnings for text
+// colors failing the theme's minimum contrast, e.g os.Stderr within a build.
+// It is nil by default, leaving the warnings to the comment of the stylesheet.
+var ContrastWarnings io.Writer
+
+// RelativeLuminance returns the relative luminance of the color as defined by
+// the WCAG 2.0 guidelines, where 0 is black and 1 is white.
+func RelativeLuminance(c Color) float64 {
+	r, g, b := c.C.LinearRgb()
+	return (0.2126 * r) + (0.7152 * g) + (0.0722 * b)
+}
+
+// ContrastRatio returns the WCAG 2.0 contrast ratio between the two colors,
+// ranging from 1 for the same colors to 21 for black and white.
+func ContrastRatio(a, b Color) float64 {
+	la, lb := RelativeLuminance(a), RelativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// AccessibleForeground returns the first of the candidates which meets the
+// minimum contrast against the background, e.g AAContrast, else returning the
+// candidate, black or white with the highest contrast against the background.
+func AccessibleForeground(background Color, minimum float64, candidates ...Color) Color {
+	for _, candidate := range candidates {
+		if ContrastRatio(candidate, background) >= minimum {
+			return candidate
+		}
+	}
+
+	best, bestRatio := black, ContrastRatio(black, background)
+
+	for _, candidate := range append(candidates, white) {
+		if ratio := ContrastRatio(candidate, background); ratio > bestRatio {
+			best, bestRatio = candidate, ratio
+		}
+	}
+
+	return best
+}
+
+// Foreground returns the accessible foreground color for text placed on the
+// base color of the tones, preferring the darkest and lightest grades of the
+// tones before black or white.
+func (t Tones) Foreground(minimum float64) Color {
+	return AccessibleForeground(t.Base, minimum, t.extremes()...)
+}
+
+// Foregrounds returns the accessible foreground color for text placed on each
+// grade of the tones, in the same order as the grades.
+func (t Tones) Foregrounds(minimum float64) []Color {
+	extremes := t.extremes()
+
+	var fgs []Color
+	for _, grade := range t.Grades {
+		fgs = append(fgs, AccessibleForeground(grade, minimum, extremes...))
+	}
+
+	return fgs
+}
+
+// extremes returns the darkest and lightest grades of the tones.
+func (t Tones) extremes() []Color {
+	if len(t.Grades) == 0 {
+		return nil
+	}
+
+	return []Color{t.Grades[0], t.Grades[len(t.Grades)-1]}
+}
+
+//================================================================================================
+
+// ContrastPair defines a text color and background color pair used by the
+// styleguide with the contrast ratio between them.
+type ContrastPair struct {
+	Name       string
+	Foreground Color
+	Background Color
+	Ratio      float64
+}
+
+// String returns a readable description of the pair.
+func (c ContrastPair) String() string {
+	return fmt.Sprintf("%s (%s) on %s has a contrast ratio of %.2f", c.Name, c.Foreground, c.Background, c.Ratio)
+}
+
+// ContrastReport returns the pairs of text colors and backgrounds generated for
+// the theme whose contrast ratio is below the theme's MinimumContrast. The base
+// of each color is checked as text on the white color, and the accessible
+// foreground of each color is checked as text on the color.
+func ContrastReport(attr common.Theme) ([]ContrastPair, error) {
+	attr = initAttr(attr)
+
+	brand, err := brandColors(attr)
+	if err != nil {
+		return nil, err
+	}
+
+	return contrastFailures(attr, brand), nil
+}
+
+// contrastFailures returns the pairs of the brand colors failing the minimum
+// contrast of the theme.
+func contrastFailures(attr common.Theme, brand styleColors) []ContrastPair {
+	var failures []ContrastPair
+
+	for _, named := range brand.list() {
+		switch named.Name {
+		case "white":
+			continue
+		case "primary-brand":
+			if attr.PrimaryBrandColor == "" {
+				continue
+			}
+		case "secondary-brand":
+			if attr.SecondaryBrandColor == "" {
+				continue
+			}
+		}
+
+		if ratio := ContrastRatio(named.Tones.Base, brand.White.Base); ratio < attr.MinimumContrast {
+			failures = append(failures, ContrastPair{
+				Name:       "color-" + named.Name,
+				Foreground: named.Tones.Base,
+				Background: brand.White.Base,
+				Ratio:      ratio,
+			})
+		}
+
+		fg := named.Tones.Foreground(attr.MinimumContrast)
+		if ratio := ContrastRatio(fg, named.Tones.Base); ratio < attr.MinimumContrast {
+			failures = append(failures, ContrastPair{
+				Name:       "text on background-color-" + named.Name,
+				Foreground: fg,
+				Background: named.Tones.Base,
+				Ratio:      ratio,
+			})
+		}
+	}
+
+	return failures
+}
+
+// writeContrastWarnings writes the failing pairs as a css comment into the
+// writer and as warnings into ContrastWarnings if set.
+func writeContrastWarnings(w io.Writer, minimum float64, failures []ContrastPair) error {
+	if len(failures) == 0 {
+		return nil
+	}
+
+	var content bytes.Buffer
+
+	fmt.Fprintf(&content, "/*\n  Contrast warnings: the following pairs are below the minimum contrast of %.2f.\n\n", minimum)
+
+	for _, failure := range failures {
+		fmt.Fprintf(&content, "  %s.\n", failure)
+
+		if ContrastWarnings != nil {
+			fmt.Fprintf(ContrastWarnings, "styleguide: %s, below the minimum contrast of %.2f\n", failure, minimum)
+		}
+	}
+
+	content.WriteString("*/\n\n")
+
+	_, err := content.WriteTo(w)
+	return err
+}
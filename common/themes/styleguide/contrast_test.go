@@ -0,0 +1,95 @@
+package styleguide_test
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/themes/styleguide"
+	"github.com/influx6/faux/tests"
+)
+
+func mustColor(t *testing.T, value string) styleguide.Color {
+	c, err := styleguide.ColorFrom(value)
+	if err != nil {
+		t.Fatalf("Should have parsed color %q: %+q", value, err)
+	}
+
+	return c
+}
+
+func TestContrastRatio(t *testing.T) {
+	black, white := mustColor(t, "#000000"), mustColor(t, "#ffffff")
+
+	for _, pair := range []struct {
+		a, b  string
+		ratio float64
+	}{
+		{a: "#000000", b: "#ffffff", ratio: 21},
+		{a: "#ffffff", b: "#000000", ratio: 21},
+		{a: "#ffffff", b: "#ffffff", ratio: 1},
+		{a: "#777777", b: "#ffffff", ratio: 4.48},
+		{a: "#767676", b: "#ffffff", ratio: 4.54},
+		{a: "#0000ff", b: "#ffffff", ratio: 8.59},
+	} {
+		if ratio := styleguide.ContrastRatio(mustColor(t, pair.a), mustColor(t, pair.b)); math.Abs(ratio-pair.ratio) > 0.01 {
+			t.Logf("\t\tExpected: %.2f for %s on %s\n", pair.ratio, pair.a, pair.b)
+			t.Logf("\t\tRecieved: %.2f\n", ratio)
+			tests.Failed("Should have matched the WCAG contrast ratio")
+		}
+	}
+	tests.Passed("Should have matched the WCAG contrast ratio")
+
+	gray, lighter := mustColor(t, "#777777"), mustColor(t, "#767676")
+
+	if fg := styleguide.AccessibleForeground(white, styleguide.AAContrast, gray, lighter); fg.String() != lighter.String() {
+		t.Logf("\t\tRecieved: %s\n", fg)
+		tests.Failed("Should have picked the first candidate meeting the minimum contrast")
+	}
+	tests.Passed("Should have picked the first candidate meeting the minimum contrast")
+
+	if fg := styleguide.AccessibleForeground(white, styleguide.AALargeContrast, gray, lighter); fg.String() != gray.String() {
+		t.Logf("\t\tRecieved: %s\n", fg)
+		tests.Failed("Should have applied the provided minimum contrast")
+	}
+	tests.Passed("Should have applied the provided minimum contrast")
+
+	if fg := styleguide.AccessibleForeground(white, styleguide.AAAContrast, gray); fg.String() != black.String() {
+		t.Logf("\t\tRecieved: %s\n", fg)
+		tests.Failed("Should have fallen back to the color with the highest contrast")
+	}
+	tests.Passed("Should have fallen back to the color with the highest contrast")
+}
+
+func TestContrastForegrounds(t *testing.T) {
+	var out bytes.Buffer
+
+	if err := styleguide.Render(&out, common.Theme{CustomProperties: true}); err != nil {
+		tests.Failed("Should have rendered styleguide with custom properties: %+q", err)
+	}
+	tests.Passed("Should have rendered styleguide with custom properties")
+
+	content := out.String()
+
+	for _, expected := range []string{"--primary-on:", "--primary-500-on:", "color: var(--primary-on);", "color: var(--primary-500-on);"} {
+		if !strings.Contains(content, expected) {
+			t.Logf("\t\tExpected: %q\n", expected)
+			tests.Failed("Should have rendered the accessible foreground of each tone")
+		}
+	}
+	tests.Passed("Should have rendered the accessible foreground of each tone")
+
+	out.Reset()
+
+	if err := styleguide.Render(&out, common.Theme{}); err != nil {
+		tests.Failed("Should have rendered styleguide: %+q", err)
+	}
+	tests.Passed("Should have rendered styleguide")
+
+	if strings.Contains(out.String(), "color: <") || strings.Contains(out.String(), "var(--") {
+		tests.Failed("Should have rendered foreground colors as values without custom properties")
+	}
+	tests.Passed("Should have rendered foreground colors as values without custom properties")
+}
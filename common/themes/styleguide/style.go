@@ -1,6 +1,7 @@
 package styleguide
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"text/template"
 
 	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/trees/css"
 	"github.com/influx6/faux/colors"
 	colorful "github.com/lucasb-eyer/go-colorful"
 )
@@ -143,76 +145,125 @@ type styleColors struct {
 
 // Render initializes the style guide and all internal properties into
 // appropriate defaults and states and generates a css style written into
-// the provided writer
+// the provided writer. If the theme has CustomProperties set, the colors are
+// written as css custom properties with a variant block for each of the
+// light, dark and high contrast variants, which the styles then reference.
+// If the theme's Direction is "rtl" the styles are run through the css.Flip
+// transform, swapping their left and right sides for right-to-left locales.
+// Background classes set the accessible foreground of their color for the
+// theme's MinimumContrast as their text color. Text colors failing the
+// MinimumContrast are reported in a comment at the top of the stylesheet and
+// written into ContrastWarnings if set.
 func Render(w io.Writer, attr common.Theme) error {
+	attr = initAttr(attr)
+
+	brand, err := brandColors(attr)
+	if err != nil {
+		return err
+	}
+
+	tml, err := template.New("styleguide").Funcs(helpers).Parse(styleTemplate)
+	if err != nil {
+		return err
+	}
+
+	if err := writeContrastWarnings(w, attr.MinimumContrast, contrastFailures(attr, brand)); err != nil {
+		return err
+	}
+
+	var styles bytes.Buffer
+	var colors interface{} = brand
+
+	if attr.CustomProperties {
+		if err := writeCustomProperties(&styles, attr.MinimumContrast, brand); err != nil {
+			return err
+		}
+
+		colors = variableColors(brand)
+	}
+
+	shm, bhm := GenerateValueScale(1, attr.HeaderBaseScale, attr.MinimumHeadScaleCount, attr.MaximumHeadScaleCount)
+	sm, bg := GenerateValueScale(1, attr.BaseScale, attr.MinimumScaleCount, attr.MaximumScaleCount)
+
+	err = tml.Execute(&styles, struct {
+		common.Theme
+		Brand            interface{}
+		SmallFontScale   []float64
+		BigFontScale     []float64
+		SmallHeaderScale []float64
+		BigHeaderScale   []float64
+	}{
+		SmallFontScale:   sm,
+		BigFontScale:     bg,
+		SmallHeaderScale: shm,
+		BigHeaderScale:   bhm,
+		Brand:            colors,
+		Theme:            attr,
+	})
+	if err != nil {
+		return err
+	}
+
+	if attr.Direction == string(css.RTL) {
+		sheet, err := css.Plain(styles.String(), nil).Stylesheet(nil, "")
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, css.Format(css.Flip(sheet)))
+		return err
+	}
+
+	_, err = styles.WriteTo(w)
+	return err
+}
+
+// brandColors returns the tones generated for all colors of the provided theme.
+func brandColors(attr common.Theme) (styleColors, error) {
 	var err error
 
 	var brand styleColors
 
-	attr = initAttr(attr)
-
 	if attr.PrimaryBrandColor != "" {
 		brand.PrimaryBrand, err = NewTones(attr.PrimaryBrandColor)
 		if err != nil {
-			return errors.New("Invalid primary brand color: " + err.Error())
+			return brand, errors.New("Invalid primary brand color: " + err.Error())
 		}
 	}
 
 	if attr.SecondaryBrandColor != "" {
 		brand.SecondaryBrand, err = NewTones(attr.SecondaryBrandColor)
 		if err != nil {
-			return errors.New("Invalid secondary brand color: " + err.Error())
+			return brand, errors.New("Invalid secondary brand color: " + err.Error())
 		}
 	}
 
 	brand.Primary, err = NewTones(attr.PrimaryColor)
 	if err != nil {
-		return errors.New("Invalid primary color: " + err.Error())
+		return brand, errors.New("Invalid primary color: " + err.Error())
 	}
 
 	brand.Secondary, err = NewTones(attr.SecondaryColor)
 	if err != nil {
-		return errors.New("Invalid secondary color: " + err.Error())
+		return brand, errors.New("Invalid secondary color: " + err.Error())
 	}
 
 	brand.White, err = NewTones(attr.PrimaryWhite)
 	if err != nil {
-		return errors.New("Invalid white color: " + err.Error())
+		return brand, errors.New("Invalid white color: " + err.Error())
 	}
 
 	brand.Success, err = NewTones(attr.SuccessColor)
 	if err != nil {
-		return errors.New("Invalid success color: " + err.Error())
+		return brand, errors.New("Invalid success color: " + err.Error())
 	}
 
 	brand.Failure, err = NewTones(attr.FailureColor)
 	if err != nil {
-		return errors.New("Invalid failure color: " + err.Error())
-	}
-
-	tml, err := template.New("styleguide").Funcs(helpers).Parse(styleTemplate)
-	if err != nil {
-		return err
+		return brand, errors.New("Invalid failure color: " + err.Error())
 	}
 
-	shm, bhm := GenerateValueScale(1, attr.HeaderBaseScale, attr.MinimumHeadScaleCount, attr.MaximumHeadScaleCount)
-	sm, bg := GenerateValueScale(1, attr.BaseScale, attr.MinimumScaleCount, attr.MaximumScaleCount)
-
-	return tml.Execute(w, struct {
-		common.Theme
-		Brand            styleColors
-		SmallFontScale   []float64
-		BigFontScale     []float64
-		SmallHeaderScale []float64
-		BigHeaderScale   []float64
-	}{
-		SmallFontScale:   sm,
-		BigFontScale:     bg,
-		SmallHeaderScale: shm,
-		BigHeaderScale:   bhm,
-		Brand:            brand,
-		Theme:            attr,
-	})
+	return brand, nil
 }
 
 //================================================================================================
@@ -337,6 +388,10 @@ func initAttr(attr common.Theme) common.Theme {
 		attr.LargeBorderRadius = largeBorderRadius
 	}
 
+	if attr.MinimumContrast <= 0 {
+		attr.MinimumContrast = AAContrast
+	}
+
 	return attr
 }
 
@@ -441,6 +441,16 @@ ____________ Font scale set ____________________________
     margin-left: -0.05em;
 }
 
+[dir="rtl"] .blockquote-font:before, [dir="rtl"] .blockquote-font-contrast:before {
+    left: auto;
+    right: -0.5em;
+}
+
+[dir="rtl"] .blockquote-font:after, [dir="rtl"] .blockquote-font-contrast:after {
+    margin-left: 0;
+    margin-right: -0.05em;
+}
+
 .menu-font {
   font-size: 14px;
   font-weight: 500;
@@ -526,12 +536,14 @@ ____________ Color set ____________________________
 
 .brand-background-color-primary {
 	background: {{.Brand.PrimaryBrand.Base}};
+	color: {{.Brand.PrimaryBrand.Foreground $.MinimumContrast}};
 }
 
 {{ range $index, $item := .Brand.PrimaryBrand.Grades }}
 {{ $rn := add $index 1 }}
 .brand-background-color-primary-{{ multiply $rn 10}} {
 	background: {{$item}};
+	color: {{ index ($.Brand.PrimaryBrand.Foregrounds $.MinimumContrast) $index }};
 }
 
 .brand-border-color-primary-{{ multiply $rn 10}} {
@@ -554,12 +566,14 @@ ____________ Color set ____________________________
 
 .background-color-primary {
 	background: {{.Brand.Primary.Base}};
+	color: {{.Brand.Primary.Foreground $.MinimumContrast}};
 }
 
 {{ range $index, $item := .Brand.Primary.Grades }}
 {{ $rn := add $index 1 }}
 .background-color-primary-{{ multiply $rn 10}} {
 	background: {{$item}};
+	color: {{ index ($.Brand.Primary.Foregrounds $.MinimumContrast) $index }};
 }
 
 .color-primary-{{ multiply $rn 10}} {
@@ -581,6 +595,7 @@ ____________ Color set ____________________________
 
 .background-color-secondary {
 	background: {{.Brand.Secondary.Base}};
+	color: {{.Brand.Secondary.Foreground $.MinimumContrast}};
 }
 
 .border-color-secondary {
@@ -591,6 +606,7 @@ ____________ Color set ____________________________
 {{ $rn := add $index 1 }}
 .background-color-secondary-{{ multiply $rn 10}} {
 	background: {{$item}};
+	color: {{ index ($.Brand.Secondary.Foregrounds $.MinimumContrast) $index }};
 }
 
 .border-color-secondary-{{ multiply $rn 10}} {
@@ -608,6 +624,7 @@ ____________ Color set ____________________________
 
 .brand-background-color-secondary {
 	background: {{.Brand.SecondaryBrand.Base}};
+	color: {{.Brand.SecondaryBrand.Foreground $.MinimumContrast}};
 }
 
 .brand-border-color-secondary {
@@ -618,6 +635,7 @@ ____________ Color set ____________________________
 {{ $rn := add $index 1 }}
 .brand-background-color-secondary-{{ multiply $rn 10}} {
 	background: {{$item}};
+	color: {{ index ($.Brand.SecondaryBrand.Foregrounds $.MinimumContrast) $index }};
 }
 
 .brand-border-color-secondary-{{ multiply $rn 10}} {
@@ -640,6 +658,7 @@ ____________ Color set ____________________________
 
 .background-color-success {
 	background: {{.Brand.Success.Base}};
+	color: {{.Brand.Success.Foreground $.MinimumContrast}};
 }
 
 .border-color-success {
@@ -650,6 +669,7 @@ ____________ Color set ____________________________
 {{ $rn := add $index 1 }}
 .background-color-success-{{ multiply $rn 10}} {
 	background: {{$item}};
+	color: {{ index ($.Brand.Success.Foregrounds $.MinimumContrast) $index }};
 }
 
 .brand-success-{{ multiply $rn 10}} {
@@ -667,6 +687,7 @@ ____________ Color set ____________________________
 
 .background-color-white {
 	background: {{.Brand.White.Base}};
+	color: {{.Brand.White.Foreground $.MinimumContrast}};
 }
 
 .brand-white {
@@ -681,6 +702,7 @@ ____________ Color set ____________________________
 {{ $rn := add $index 1 }}
 .background-color-white-{{ multiply $rn 10}} {
 	background: {{$item}};
+	color: {{ index ($.Brand.White.Foregrounds $.MinimumContrast) $index }};
 }
 
 .brand-white-{{ multiply $rn 10}} {
@@ -698,6 +720,7 @@ ____________ Color set ____________________________
 
 .background-color-failure {
 	background: {{.Brand.Failure.Base}};
+	color: {{.Brand.Failure.Foreground $.MinimumContrast}};
 }
 
 .brand-failure {
@@ -712,6 +735,7 @@ ____________ Color set ____________________________
 {{ $rn := add $index 1 }}
 .background-color-failure-{{ multiply $rn 10}} {
 	background: {{$item}};
+	color: {{ index ($.Brand.Failure.Foregrounds $.MinimumContrast) $index }};
 }
 
 .brand-failure-{{ multiply $rn 10}} {
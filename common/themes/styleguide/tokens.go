@@ -0,0 +1,271 @@
+package styleguide
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gu-io/gu/common"
+)
+
+// contains the types of design tokens exported from a theme, these follow the
+// types defined by the W3C design tokens format.
+const (
+	ColorToken       = "color"
+	DimensionToken   = "dimension"
+	ShadowToken      = "shadow"
+	CubicBezierToken = "cubicBezier"
+)
+
+// Token defines a single design token resolved from a theme, where Value is
+// the css value used within the rendered styleguide.
+type Token struct {
+	Group string
+	Name  string
+	Type  string
+	Value string
+}
+
+// GoName returns the name of the token as an exported Go identifier e.g
+// primary-500 becomes Primary500.
+func (t Token) GoName() string {
+	var name []string
+
+	for _, part := range strings.Split(t.Name, "-") {
+		if part == "" {
+			continue
+		}
+
+		name = append(name, strings.ToUpper(part[:1])+part[1:])
+	}
+
+	return strings.Join(name, "")
+}
+
+// Tokens returns the design tokens of the provided theme, which are the color
+// tones, font scales, shadows, border radius and animation curves used by the
+// styleguide. Color tokens are named after the custom properties of the colors
+// e.g primary-500.
+func Tokens(attr common.Theme) ([]Token, error) {
+	attr = initAttr(attr)
+
+	brand, err := brandColors(attr)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []Token
+
+	for _, named := range brand.list() {
+		if len(named.Tones.Grades) == 0 {
+			continue
+		}
+
+		tokens = append(tokens, Token{Group: "color", Name: named.Name, Type: ColorToken, Value: named.Tones.Base.String()})
+
+		for index, grade := range named.Tones.Grades {
+			tokens = append(tokens, Token{
+				Group: "color",
+				Name:  fmt.Sprintf("%s-%d", named.Name, gradeNumber(index)),
+				Type:  ColorToken,
+				Value: grade.String(),
+			})
+		}
+	}
+
+	tokens = append(tokens, Token{Group: "font-size", Name: "font-size-base", Type: DimensionToken, Value: fmt.Sprintf("%dpx", attr.BaseFontSize)})
+
+	shm, bhm := GenerateValueScale(1, attr.HeaderBaseScale, attr.MinimumHeadScaleCount, attr.MaximumHeadScaleCount)
+	sm, bg := GenerateValueScale(1, attr.BaseScale, attr.MinimumScaleCount, attr.MaximumScaleCount)
+
+	tokens = append(tokens, scaleTokens("small-heading", shm)...)
+	tokens = append(tokens, scaleTokens("big-heading", bhm)...)
+	tokens = append(tokens, scaleTokens("small-font", sm)...)
+	tokens = append(tokens, scaleTokens("big-font", bg)...)
+
+	tokens = append(tokens,
+		Token{Group: "shadow", Name: "shadow-base", Type: ShadowToken, Value: attr.BaseShadow},
+		Token{Group: "shadow", Name: "shadow-hover", Type: ShadowToken, Value: attr.HoverShadow},
+		Token{Group: "shadow", Name: "shadow-drop", Type: ShadowToken, Value: attr.DropShadow},
+		Token{Group: "shadow", Name: "shadow-floating", Type: ShadowToken, Value: attr.FloatingShadow},
+		Token{Group: "border-radius", Name: "border-radius-sm", Type: DimensionToken, Value: fmt.Sprintf("%dpx", attr.SmallBorderRadius)},
+		Token{Group: "border-radius", Name: "border-radius-md", Type: DimensionToken, Value: fmt.Sprintf("%dpx", attr.MediumBorderRadius)},
+		Token{Group: "border-radius", Name: "border-radius-lg", Type: DimensionToken, Value: fmt.Sprintf("%dpx", attr.LargeBorderRadius)},
+		Token{Group: "animation", Name: "curve-default", Type: CubicBezierToken, Value: attr.AnimationCurveDefault},
+		Token{Group: "animation", Name: "curve-fast-out-slow-in", Type: CubicBezierToken, Value: attr.AnimationCurveFastOutSlowIn},
+		Token{Group: "animation", Name: "curve-fast-out-linear-in", Type: CubicBezierToken, Value: attr.AnimationCurveFastOutLinearIn},
+		Token{Group: "animation", Name: "curve-linear-out-slow-in", Type: CubicBezierToken, Value: attr.AnimationCurveLinearOutSlowIn},
+	)
+
+	return tokens, nil
+}
+
+// scaleTokens returns the font size tokens for the giving scale, where each
+// value is an em unit.
+func scaleTokens(prefix string, scale []float64) []Token {
+	var tokens []Token
+
+	for index, value := range scale {
+		tokens = append(tokens, Token{
+			Group: "font-size",
+			Name:  fmt.Sprintf("%s-%d", prefix, index+1),
+			Type:  DimensionToken,
+			Value: strconv.FormatFloat(value, 'f', -1, 64) + "em",
+		})
+	}
+
+	return tokens
+}
+
+//================================================================================================
+
+// WriteTokensJSON writes the design tokens of the theme as a flat json object of
+// token names to their css values into the writer.
+func WriteTokensJSON(w io.Writer, attr common.Theme) error {
+	tokens, err := Tokens(attr)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(tokens))
+	for _, token := range tokens {
+		values[token.Name] = token.Value
+	}
+
+	return writeJSON(w, values)
+}
+
+// WriteDesignTokens writes the design tokens of the theme in the W3C design
+// tokens format into the writer, where tokens are nested within their groups.
+func WriteDesignTokens(w io.Writer, attr common.Theme) error {
+	tokens, err := Tokens(attr)
+	if err != nil {
+		return err
+	}
+
+	groups := make(map[string]map[string]interface{})
+
+	for _, token := range tokens {
+		group, ok := groups[token.Group]
+		if !ok {
+			group = make(map[string]interface{})
+			groups[token.Group] = group
+		}
+
+		group[token.Name] = map[string]interface{}{
+			"$type":  token.Type,
+			"$value": designValue(token),
+		}
+	}
+
+	return writeJSON(w, groups)
+}
+
+// WriteGoTokens writes a Go source file for the giving package into the writer,
+// containing the design tokens of the theme as string constants e.g
+// tokens.Primary500. The file starts with the standard header marking it as
+// generated code.
+func WriteGoTokens(w io.Writer, pkg string, attr common.Theme) error {
+	tokens, err := Tokens(attr)
+	if err != nil {
+		return err
+	}
+
+	var content bytes.Buffer
+
+	content.WriteString("// Code generated by styleguide.WriteGoTokens. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&content, "// Package %s contains the design tokens of the styleguide theme.\n", pkg)
+	fmt.Fprintf(&content, "package %s\n\n", pkg)
+
+	var lastGroup string
+
+	content.WriteString("const (\n")
+
+	for _, token := range tokens {
+		if token.Group != lastGroup {
+			if lastGroup != "" {
+				content.WriteString("\n")
+			}
+
+			fmt.Fprintf(&content, "// %s tokens.\n", token.Group)
+			lastGroup = token.Group
+		}
+
+		fmt.Fprintf(&content, "%s = %q\n", token.GoName(), token.Value)
+	}
+
+	content.WriteString(")\n")
+
+	src, err := format.Source(content.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+// writeJSON writes the indented json of the value into the writer.
+func writeJSON(w io.Writer, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// designValue returns the value of the token as expected by the W3C design
+// tokens format for its type, falling back to the css value when the value
+// can not be converted.
+func designValue(token Token) interface{} {
+	switch token.Type {
+	case CubicBezierToken:
+		value := strings.TrimSpace(token.Value)
+		if !strings.HasPrefix(value, "cubic-bezier(") || !strings.HasSuffix(value, ")") {
+			return token.Value
+		}
+
+		var points []float64
+
+		for _, point := range strings.Split(value[len("cubic-bezier("):len(value)-1], ",") {
+			pf, err := strconv.ParseFloat(strings.TrimSpace(point), 64)
+			if err != nil {
+				return token.Value
+			}
+
+			points = append(points, pf)
+		}
+
+		if len(points) != 4 {
+			return token.Value
+		}
+
+		return points
+	case ShadowToken:
+		fields := strings.Fields(token.Value)
+		if len(fields) < 5 {
+			return token.Value
+		}
+
+		color, err := ColorFrom(strings.Join(fields[4:], ""))
+		if err != nil {
+			return token.Value
+		}
+
+		return map[string]string{
+			"offsetX": fields[0],
+			"offsetY": fields[1],
+			"blur":    fields[2],
+			"spread":  fields[3],
+			"color":   fmt.Sprintf("%s%02x", color, int(color.Alpha*255+0.5)),
+		}
+	default:
+		return token.Value
+	}
+}
@@ -0,0 +1,79 @@
+package styleguide_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/themes/styleguide"
+	"github.com/influx6/faux/tests"
+)
+
+func TestTokens(t *testing.T) {
+	var flat bytes.Buffer
+
+	if err := styleguide.WriteTokensJSON(&flat, common.Theme{}); err != nil {
+		tests.Failed("Should have written tokens as json: %+q", err)
+	}
+	tests.Passed("Should have written tokens as json")
+
+	var values map[string]string
+	if err := json.Unmarshal(flat.Bytes(), &values); err != nil {
+		tests.Failed("Should have written valid json: %+q", err)
+	}
+	tests.Passed("Should have written valid json")
+
+	if values["primary-500"] == "" || values["primary-1000"] == "" || values["primary-brand"] != "" {
+		t.Logf("\t\tRecieved: %q\n", values["primary-500"])
+		tests.Failed("Should have named color tokens by grade without unset brand colors")
+	}
+	tests.Passed("Should have named color tokens by grade without unset brand colors")
+
+	var design bytes.Buffer
+
+	if err := styleguide.WriteDesignTokens(&design, common.Theme{}); err != nil {
+		tests.Failed("Should have written design tokens: %+q", err)
+	}
+	tests.Passed("Should have written design tokens")
+
+	var groups map[string]map[string]map[string]interface{}
+	if err := json.Unmarshal(design.Bytes(), &groups); err != nil {
+		tests.Failed("Should have written valid design tokens json: %+q", err)
+	}
+	tests.Passed("Should have written valid design tokens json")
+
+	if primary := groups["color"]["primary-500"]; primary["$type"] != styleguide.ColorToken || primary["$value"] != values["primary-500"] {
+		t.Logf("\t\tRecieved: %+v\n", primary)
+		tests.Failed("Should have grouped tokens with their type and value")
+	}
+	tests.Passed("Should have grouped tokens with their type and value")
+
+	var src bytes.Buffer
+
+	if err := styleguide.WriteGoTokens(&src, "tokens", common.Theme{}); err != nil {
+		tests.Failed("Should have written go tokens: %+q", err)
+	}
+	tests.Passed("Should have written go tokens")
+
+	if !strings.HasPrefix(src.String(), "// Code generated by styleguide.WriteGoTokens. DO NOT EDIT.\n") {
+		t.Logf("\t\tRecieved: %q\n", strings.SplitN(src.String(), "\n", 2)[0])
+		tests.Failed("Should have started go tokens with generated code header")
+	}
+	tests.Passed("Should have started go tokens with generated code header")
+
+	file, err := parser.ParseFile(token.NewFileSet(), "tokens.go", src.Bytes(), parser.ParseComments)
+	if err != nil {
+		tests.Failed("Should have written valid go source: %+q", err)
+	}
+	tests.Passed("Should have written valid go source")
+
+	if !ast.IsGenerated(file) || file.Scope.Lookup("Primary500") == nil {
+		tests.Failed("Should have declared Primary500 within generated file")
+	}
+	tests.Passed("Should have declared Primary500 within generated file")
+}
@@ -0,0 +1,216 @@
+package styleguide
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/gu-io/gu/common"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// gradeStep defines the step between the numbers naming the tone grades of a
+// color in custom properties and design tokens, so the twenty grades of a Tones
+// are named from 50 to 1000 with the middle grade named 500.
+const gradeStep = 50
+
+// gradeNumber returns the number naming the tone grade at the index.
+func gradeNumber(index int) int {
+	return (index + 1) * gradeStep
+}
+
+// contrastFactor defines how far the luminosity of tones are pushed away from
+// the middle for the high contrast variant.
+const contrastFactor = 0.5
+
+// namedTones defines a Tones with the custom property name used for it.
+type namedTones struct {
+	Name  string
+	Tones Tones
+}
+
+// list returns the tones of the style colors paired with their custom property
+// names.
+func (s styleColors) list() []namedTones {
+	return []namedTones{
+		{Name: "primary", Tones: s.Primary},
+		{Name: "secondary", Tones: s.Secondary},
+		{Name: "success", Tones: s.Success},
+		{Name: "failure", Tones: s.Failure},
+		{Name: "white", Tones: s.White},
+		{Name: "primary-brand", Tones: s.PrimaryBrand},
+		{Name: "secondary-brand", Tones: s.SecondaryBrand},
+	}
+}
+
+// variableTones defines the var() references for a Tones, it mirrors the Tones
+// fields and methods used by the style template.
+type variableTones struct {
+	Base   string
+	Grades []string
+
+	foreground  string
+	foregrounds []string
+}
+
+// Foreground returns the var() reference to the foreground of the base color,
+// the minimum contrast is applied when writing the custom properties.
+func (v variableTones) Foreground(minimum float64) string {
+	return v.foreground
+}
+
+// Foregrounds returns the var() references to the foregrounds of the grades.
+func (v variableTones) Foregrounds(minimum float64) []string {
+	return v.foregrounds
+}
+
+// variableColors returns a mirror of the styleColors where each color is a
+// var() reference to its custom property.
+func variableColors(s styleColors) interface{} {
+	vars := make(map[string]variableTones)
+
+	for _, named := range s.list() {
+		var vt variableTones
+		vt.Base = fmt.Sprintf("var(--%s)", named.Name)
+		vt.foreground = fmt.Sprintf("var(--%s-on)", named.Name)
+
+		for index := range named.Tones.Grades {
+			vt.Grades = append(vt.Grades, fmt.Sprintf("var(--%s-%d)", named.Name, gradeNumber(index)))
+			vt.foregrounds = append(vt.foregrounds, fmt.Sprintf("var(--%s-%d-on)", named.Name, gradeNumber(index)))
+		}
+
+		vars[named.Name] = vt
+	}
+
+	return struct {
+		Primary        variableTones
+		Secondary      variableTones
+		Success        variableTones
+		Failure        variableTones
+		White          variableTones
+		PrimaryBrand   variableTones
+		SecondaryBrand variableTones
+	}{
+		Primary:        vars["primary"],
+		Secondary:      vars["secondary"],
+		Success:        vars["success"],
+		Failure:        vars["failure"],
+		White:          vars["white"],
+		PrimaryBrand:   vars["primary-brand"],
+		SecondaryBrand: vars["secondary-brand"],
+	}
+}
+
+// writeCustomProperties writes the colors as css custom properties into the
+// writer, with the light variant on the root and the dark and high contrast
+// variants selected through the common.ThemeVariantAttr attribute or the user's
+// preferences when no variant is set. Each color and grade also gets an -on
+// property holding its accessible foreground for the minimum contrast.
+func writeCustomProperties(w io.Writer, minimum float64, brand styleColors) error {
+	dark := darkVariant(brand)
+	contrast := highContrastVariant(brand)
+
+	var content bytes.Buffer
+
+	writeVariables(&content, ":root", "", minimum, brand)
+	writeVariables(&content, fmt.Sprintf(":root[%s=%q]", common.ThemeVariantAttr, common.LightVariant), "", minimum, brand)
+	writeVariables(&content, fmt.Sprintf(":root[%s=%q]", common.ThemeVariantAttr, common.DarkVariant), "", minimum, dark)
+	writeVariables(&content, fmt.Sprintf(":root[%s=%q]", common.ThemeVariantAttr, common.HighContrastVariant), "", minimum, contrast)
+
+	content.WriteString("@media (prefers-color-scheme: dark) {\n")
+	writeVariables(&content, fmt.Sprintf(":root:not([%s])", common.ThemeVariantAttr), "\t", minimum, dark)
+	content.WriteString("}\n\n")
+
+	content.WriteString("@media (prefers-contrast: more) {\n")
+	writeVariables(&content, fmt.Sprintf(":root:not([%s])", common.ThemeVariantAttr), "\t", minimum, contrast)
+	content.WriteString("}\n")
+
+	_, err := content.WriteTo(w)
+	return err
+}
+
+// writeVariables writes the custom properties of the colors as a block for the
+// provided selector, leaving out the brand colors the theme does not set.
+func writeVariables(w *bytes.Buffer, selector string, indent string, minimum float64, brand styleColors) {
+	fmt.Fprintf(w, "%s%s {\n", indent, selector)
+
+	for _, named := range brand.list() {
+		if len(named.Tones.Grades) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s\t--%s: %s;\n", indent, named.Name, named.Tones.Base)
+		fmt.Fprintf(w, "%s\t--%s-on: %s;\n", indent, named.Name, named.Tones.Foreground(minimum))
+
+		foregrounds := named.Tones.Foregrounds(minimum)
+
+		for index, grade := range named.Tones.Grades {
+			fmt.Fprintf(w, "%s\t--%s-%d: %s;\n", indent, named.Name, gradeNumber(index), grade)
+			fmt.Fprintf(w, "%s\t--%s-%d-on: %s;\n", indent, named.Name, gradeNumber(index), foregrounds[index])
+		}
+	}
+
+	fmt.Fprintf(w, "%s}\n\n", indent)
+}
+
+//================================================================================================
+
+// darkVariant returns the dark variant of the colors, where the order of the tone
+// grades of each color are reversed, turning light tones into dark ones.
+func darkVariant(brand styleColors) styleColors {
+	return brand.mapTones(func(t Tones) Tones {
+		var grades []Color
+
+		reverse(len(t.Grades), func(index int) {
+			grades = append(grades, t.Grades[index])
+		})
+
+		return Tones{Base: t.Base, Grades: grades}
+	})
+}
+
+// highContrastVariant returns the high contrast variant of the colors, where
+// the luminosity of each tone grade is pushed away from the middle towards
+// either black or white.
+func highContrastVariant(brand styleColors) styleColors {
+	return brand.mapTones(func(t Tones) Tones {
+		var grades []Color
+
+		for _, grade := range t.Grades {
+			lumen := grade.Luminosity
+
+			if lumen < 0.5 {
+				lumen = lumen * contrastFactor
+			} else {
+				lumen = lumen + ((1 - lumen) * contrastFactor)
+			}
+
+			newColor := colorful.Hsl(grade.Hue, grade.Saturation, lumen)
+			h, s, l := newColor.Hsl()
+
+			grades = append(grades, Color{
+				C:          newColor,
+				Hue:        h,
+				Saturation: s,
+				Luminosity: l,
+				Alpha:      grade.Alpha,
+			})
+		}
+
+		return Tones{Base: t.Base, Grades: grades}
+	})
+}
+
+// mapTones returns a new styleColors where each Tones is the result of the
+// provided function.
+func (s styleColors) mapTones(fn func(Tones) Tones) styleColors {
+	return styleColors{
+		Primary:        fn(s.Primary),
+		Secondary:      fn(s.Secondary),
+		Success:        fn(s.Success),
+		Failure:        fn(s.Failure),
+		White:          fn(s.White),
+		PrimaryBrand:   fn(s.PrimaryBrand),
+		SecondaryBrand: fn(s.SecondaryBrand),
+	}
+}
@@ -0,0 +1,68 @@
+package styleguide_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/themes/styleguide"
+	"github.com/influx6/faux/tests"
+)
+
+func TestCustomProperties(t *testing.T) {
+	var out bytes.Buffer
+
+	if err := styleguide.Render(&out, common.Theme{CustomProperties: true}); err != nil {
+		tests.Failed("Should have rendered styleguide with custom properties: %+q", err)
+	}
+	tests.Passed("Should have rendered styleguide with custom properties")
+
+	content := out.String()
+
+	for _, expected := range []string{"--primary:", "--primary-50:", "--primary-500:", "--primary-1000:", "var(--primary-500)", `:root[data-theme="dark"]`} {
+		if !strings.Contains(content, expected) {
+			t.Logf("\t\tExpected: %q\n", expected)
+			tests.Failed("Should have rendered custom properties named by grade")
+		}
+	}
+	tests.Passed("Should have rendered custom properties named by grade")
+
+	if strings.Contains(content, "--primary-1050:") || strings.Contains(content, "--primary-brand:") || strings.Contains(content, "--secondary-brand:") {
+		tests.Failed("Should have left out grades and brand colors which do not exist")
+	}
+	tests.Passed("Should have left out grades and brand colors which do not exist")
+
+	out.Reset()
+
+	if err := styleguide.Render(&out, common.Theme{CustomProperties: true, PrimaryBrandColor: "#3f51b5"}); err != nil {
+		tests.Failed("Should have rendered styleguide with brand color: %+q", err)
+	}
+	tests.Passed("Should have rendered styleguide with brand color")
+
+	if !strings.Contains(out.String(), "--primary-brand-500:") || strings.Contains(out.String(), "--secondary-brand:") {
+		tests.Failed("Should have rendered custom properties of only the brand color set")
+	}
+	tests.Passed("Should have rendered custom properties of only the brand color set")
+}
+
+func TestRTLStyleguide(t *testing.T) {
+	var out bytes.Buffer
+
+	if err := styleguide.Render(&out, common.Theme{Direction: "rtl"}); err != nil {
+		tests.Failed("Should have rendered right-to-left styleguide: %+q", err)
+	}
+	tests.Passed("Should have rendered right-to-left styleguide")
+
+	content := out.String()
+
+	if !strings.Contains(content, ".blockquote-font:before, .blockquote-font-contrast:before {\n  position: absolute;\n  right: -0.5em;") {
+		tests.Failed("Should have flipped the directional properties of the styleguide")
+	}
+	tests.Passed("Should have flipped the directional properties of the styleguide")
+
+	if !strings.Contains(content, "[dir=\"rtl\"] .blockquote-font:before, [dir=\"rtl\"] .blockquote-font-contrast:before {\n  left: auto;\n  right: -0.5em;") {
+		tests.Failed("Should have left the right-to-left rules of the styleguide unflipped")
+	}
+	tests.Passed("Should have left the right-to-left rules of the styleguide unflipped")
+}
@@ -0,0 +1,122 @@
+package gu
+
+import "sync"
+
+// ComponentUpdateSubscriber defines a interface that which is used to subscribe specifically for
+// events  ComponentUpdate type.
+type ComponentUpdateSubscriber interface {
+	Receive(ComponentUpdate)
+}
+
+//=========================================================================================================
+
+// ComponentUpdateHandler defines a structure type which implements the
+// ComponentUpdateSubscriber interface and the EventDistributor interface.
+type ComponentUpdateHandler struct {
+	handle func(ComponentUpdate)
+}
+
+// NewComponentUpdateHandler returns a new instance of a ComponentUpdateHandler.
+func NewComponentUpdateHandler(fn func(ComponentUpdate)) *ComponentUpdateHandler {
+	return &ComponentUpdateHandler{
+		handle: fn,
+	}
+}
+
+// Receive takes the giving value and execute it against the underline handler.
+func (sn *ComponentUpdateHandler) Receive(elem ComponentUpdate) {
+	sn.handle(elem)
+}
+
+// Handle takes the giving value and asserts the expected value to match the
+// ComponentUpdate type then passes it to the Receive method.
+func (sn *ComponentUpdateHandler) Handle(receive interface{}) {
+	if elem, ok := receive.(ComponentUpdate); ok {
+		sn.Receive(elem)
+	}
+}
+
+//=========================================================================================================
+
+// ComponentUpdateNotification defines a structure type which must be used to
+// receive ComponentUpdate type has a event.
+type ComponentUpdateNotification struct {
+	sml        sync.Mutex
+	subs       []ComponentUpdateSubscriber
+	validation func(ComponentUpdate) bool
+	register   map[ComponentUpdateSubscriber]int
+}
+
+// NewComponentUpdateNotificationWith returns a new instance of ComponentUpdateNotification.
+func NewComponentUpdateNotificationWith(validation func(ComponentUpdate) bool) *ComponentUpdateNotification {
+	var elem ComponentUpdateNotification
+
+	elem.validation = validation
+	elem.register = make(map[ComponentUpdateSubscriber]int, 0)
+
+	return &elem
+}
+
+// NewComponentUpdateNotification returns a new instance of NewComponentUpdateNotification.
+func NewComponentUpdateNotification() *ComponentUpdateNotification {
+	var elem ComponentUpdateNotification
+	elem.register = make(map[ComponentUpdateSubscriber]int, 0)
+
+	return &elem
+}
+
+// UnNotify removes the given subscriber from the notification's list if found from future events.
+func (sn *ComponentUpdateNotification) UnNotify(sub ComponentUpdateSubscriber) {
+	sn.do(func() {
+		index, ok := sn.register[sub]
+		if !ok {
+			return
+		}
+
+		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+	})
+}
+
+// Notify adds the given subscriber into the notification list and will await an update of
+// a new event of the given ComponentUpdate type.
+func (sn *ComponentUpdateNotification) Notify(sub ComponentUpdateSubscriber) {
+	sn.do(func() {
+		sn.register[sub] = len(sn.subs)
+		sn.subs = append(sn.subs, sub)
+	})
+}
+
+// Handle takes the giving value and asserts the expected value to be of
+// the type and pass on to it's underline subscribers else ignoring the event.
+func (sn *ComponentUpdateNotification) Handle(elem interface{}) {
+	if elemEvent, ok := elem.(ComponentUpdate); ok {
+		if sn.validation != nil && sn.validation(elemEvent) {
+			sn.do(func() {
+				for _, sub := range sn.subs {
+					sub.Receive(elemEvent)
+				}
+			})
+
+			return
+		}
+
+		sn.do(func() {
+			for _, sub := range sn.subs {
+				sub.Receive(elemEvent)
+			}
+		})
+	}
+}
+
+// do performs action with the mutex locked and unlocked appropriately, ensuring safe
+// concurrent access.
+func (sn *ComponentUpdateNotification) do(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	sn.sml.Lock()
+	defer sn.sml.Unlock()
+
+	fn()
+}
@@ -28,6 +28,7 @@ function GuClient(onMessages, SendChannel) {
 
     GuJS.eventsCore = {};
     GuJS.currentAppID = null;
+    GuJS.rendered = false;
 
     // GuJS.Dispatch defines a function which dispatches event object to the external
     // API.
@@ -59,7 +60,7 @@ function GuClient(onMessages, SendChannel) {
                     eventObj.stopPropagation()
                 }
 
-                GuJS.Dispatch(GuJS.GetEvent(eventObj), eventMeta)
+                GuJS.Dispatch(GuJS.Type(eventObj), GuJS.GetEvent(eventObj), eventMeta)
             })
         }
     };
@@ -77,6 +78,7 @@ function GuClient(onMessages, SendChannel) {
         switch (co.constructor) {
             case String:
                 command = JSON.parse(co)
+                break
             case Object:
                 command = co
         }
@@ -94,6 +96,25 @@ function GuClient(onMessages, SendChannel) {
                 var app = command.App
                 GuJS.currentAppID = app.AppID
 
+                // If this is the first render and the page was rendered by the app on
+                // the server, adopt the existing DOM instead of replacing it.
+                var hydrate = !GuJS.rendered && body.hasAttribute("gu-app-id")
+                GuJS.rendered = true
+
+                // Set the locale and writing direction of the app on the root element.
+                if (app.Locale) {
+                    document.documentElement.setAttribute("lang", app.Locale)
+                    document.documentElement.setAttribute("dir", app.Direction)
+                }
+
+                // Set the theme variant of the app on the root element, removing it when
+                // no variant is set to allow the user's preferences to decide.
+                if (app.ThemeVariant) {
+                    document.documentElement.setAttribute("data-theme", app.ThemeVariant)
+                } else {
+                    document.documentElement.removeAttribute("data-theme")
+                }
+
                 // Retrieve events map related to the giving app.
                 var appEvents = GuJS.eventsCore[app.AppID] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
                 GuJS.eventsCore[app.AppID] = appEvents
@@ -103,24 +124,24 @@ function GuClient(onMessages, SendChannel) {
 
                 // Deregister all head base events.
                 GuJS.each(appEvents.base.headEvents, function(cb) {
-                    head.removeEventListener(cb.Event.Event, cb.Callback)
+                    head.removeEventListener(cb.Event.Event, cb.Callback, cb.Event.UseCapture)
                 })
 
                 // Deregister all body base events.
                 GuJS.each(appEvents.base.bodyEvents, function(cb) {
-                    body.removeEventListener(cb.Event.Event, cb.Callback)
+                    body.removeEventListener(cb.Event.Event, cb.Callback, cb.Event.UseCapture)
                 })
 
                 // Deregister all view events.
                 GuJS.each(appEvents.views, function(view) {
                     GuJS.each(view, function(cb) {
-                        body.removeEventListener(cb.Event.Event, cb.Callback)
+                        body.removeEventListener(cb.Event.Event, cb.Callback, cb.Event.UseCapture)
                     })
                 })
 
                 appEvents.base.headEvents = [];
                 appEvents.base.bodyEvents = [];
-                appEvents.base.views = {};
+                appEvents.views = {};
 
                 var headHTML = []
                 var bodyHTML = []
@@ -159,7 +180,7 @@ function GuClient(onMessages, SendChannel) {
                         newEvent.Callback = GuJS.MakeEventCallback(head, event)
 
                         head.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
-                        viewEvents.puhs(newEvent)
+                        viewEvents.push(newEvent)
                     })
 
                     headHTML.push(fragment)
@@ -205,6 +226,13 @@ function GuClient(onMessages, SendChannel) {
                     bodyHTML.push(fragment)
                 })
 
+                if (hydrate) {
+                    GuJS.Hydrate(headHTML, head)
+                    GuJS.Hydrate(bodyHTML, body)
+                    body.setAttribute("gu-app-id", app.AppID)
+                    return
+                }
+
                 head.innerHTML = ""
 
                 if (nonGuHead.length) {
@@ -256,13 +284,14 @@ function GuClient(onMessages, SendChannel) {
                 appEvents.views[view.ViewID] = viewEvents
 
 
-                // Deregister all view events.
-                GuJS.each(viewEvents, function(view) {
-                    GuJS.each(view, function(cb) {
-                        body.removeEventListener(cb.Event.Event, cb.Callback)
-                    })
+                // Deregister all view events, clearing them before the events of the
+                // new markup are registered.
+                GuJS.each(viewEvents, function(cb) {
+                    body.removeEventListener(cb.Event.Event, cb.Callback, cb.Event.UseCapture)
                 })
 
+                viewEvents.length = 0
+
                 var fragmentDOM = GuJS.createDOMFragment(view.Tree.Markup)
                 GuJS.PatchDOM(fragmentDOM, body, false)
 
@@ -278,6 +307,53 @@ function GuClient(onMessages, SendChannel) {
 
                 return
 
+            case "RenderComponent":
+                // Rendering a component patches only the node of the component within its
+                // view, replacing the events registered within the node by the events of
+                // the component's new markup.
+
+                var component = command.View
+
+                if (GuJS.currentAppID && component.AppID !== GuJS.currentAppID) {
+                    return
+                }
+
+                var componentNode = body.querySelector("[uid='" + component.Tree.TreeID + "']")
+                if (!componentNode) {
+                    return
+                }
+
+                var componentAppEvents = GuJS.eventsCore[component.AppID] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
+                GuJS.eventsCore[component.AppID] = componentAppEvents
+
+                var keptEvents = []
+
+                GuJS.each(componentAppEvents.views[component.ViewID] || [], function(registered) {
+                    var selector = registered.Event.EventSelector
+
+                    if (componentNode.matches(selector) || componentNode.querySelector(selector)) {
+                        body.removeEventListener(registered.Event.Event, registered.Callback, registered.Event.UseCapture)
+                        return
+                    }
+
+                    keptEvents.push(registered)
+                })
+
+                componentAppEvents.views[component.ViewID] = keptEvents
+
+                GuJS.PatchDOM(GuJS.createDOMFragment(component.Tree.Markup), body, false)
+
+                GuJS.each(component.Tree.Events, function(event) {
+                    var newEvent = {}
+                    newEvent.Event = event
+                    newEvent.Callback = GuJS.MakeEventCallback(body, event)
+
+                    body.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                    keptEvents.push(newEvent)
+                })
+
+                return
+
             default:
                 console.log("Command not support: ", command);
         }
@@ -394,6 +470,147 @@ function GuClient(onMessages, SendChannel) {
         }
     }
 
+    // GuJS.Hydrate adopts the nodes of the live DOM rendered on the server for the
+    // provided fragments, matching the top level nodes of each fragment by their
+    // uid and patching only the differences of the matched nodes. Nodes without
+    // a match are added and nodes generated by gu which were not adopted are
+    // removed.
+    GuJS.Hydrate = function(fragments, liveDOM) {
+        var adopted = []
+
+        GuJS.each(fragments, function(fragment) {
+            GuJS.each(GuJS.toArray(fragment.childNodes), function(node) {
+                if (node.nodeType !== 1) {
+                    return
+                }
+
+                // Match by position and tag when no uid matches, which happens when
+                // the server and client render from different app seeds.
+                var live = GuJS.findChild(liveDOM, node.getAttribute("uid")) ||
+                    GuJS.findUnadopted(liveDOM, node.tagName, adopted)
+
+                if (!live) {
+                    liveDOM.appendChild(node)
+                    adopted.push(node)
+                    return
+                }
+
+                GuJS.HydrateNode(node, live)
+                adopted.push(live)
+            })
+        })
+
+        GuJS.each(GuJS.toArray(liveDOM.children), function(node) {
+            if (node.getAttribute("data-gen") !== "gu") {
+                return
+            }
+
+            if (adopted.indexOf(node) === -1) {
+                liveDOM.removeChild(node)
+            }
+        })
+    }
+
+    // GuJS.HydrateNode patches the live node to match the shadow node, where nodes
+    // with the same uid and hash are left untouched, else the attributes are
+    // updated and the children are compared by their position.
+    GuJS.HydrateNode = function(shadow, live) {
+        if (live.getAttribute("uid") === shadow.getAttribute("uid") &&
+            live.getAttribute("hash") === shadow.getAttribute("hash")) {
+            return
+        }
+
+        GuJS.each(GuJS.toArray(live.attributes), function(attr) {
+            if (!shadow.hasAttribute(attr.name)) {
+                live.removeAttribute(attr.name)
+            }
+        })
+
+        GuJS.each(shadow.attributes, function(attr) {
+            if (live.getAttribute(attr.name) !== attr.value) {
+                live.setAttribute(attr.name, attr.value)
+            }
+        })
+
+        var shadowNodes = GuJS.filter(GuJS.toArray(shadow.childNodes), function(node) {
+            return node.nodeType !== 1 || !node.hasAttribute("NodeRemoved")
+        })
+
+        var liveNodes = GuJS.toArray(live.childNodes)
+
+        GuJS.each(shadowNodes, function(node, index) {
+            var liveNode = liveNodes[index]
+
+            if (!liveNode) {
+                live.appendChild(node)
+                return
+            }
+
+            if (liveNode.nodeType !== node.nodeType || liveNode.nodeName !== node.nodeName) {
+                live.replaceChild(node, liveNode)
+                return
+            }
+
+            if (node.nodeType === 1) {
+                GuJS.HydrateNode(node, liveNode)
+                return
+            }
+
+            if (liveNode.nodeValue !== node.nodeValue) {
+                liveNode.nodeValue = node.nodeValue
+            }
+        })
+
+        for (var index = shadowNodes.length; index < liveNodes.length; index++) {
+            live.removeChild(liveNodes[index])
+        }
+    }
+
+    // GuJS.findChild returns the child element of the parent with the provided uid.
+    GuJS.findChild = function(parent, uid) {
+        if (!uid) {
+            return null
+        }
+
+        var children = parent.children
+        for (var i = 0; i < children.length; i++) {
+            if (children[i].getAttribute("uid") === uid) {
+                return children[i]
+            }
+        }
+
+        return null
+    }
+
+    // GuJS.findUnadopted returns the first child element of the parent generated by
+    // gu with the provided tag which has not being adopted.
+    GuJS.findUnadopted = function(parent, tagName, adopted) {
+        var children = parent.children
+        for (var i = 0; i < children.length; i++) {
+            var child = children[i]
+            if (child.tagName !== tagName || child.getAttribute("data-gen") !== "gu") {
+                continue
+            }
+
+            if (adopted.indexOf(child) === -1) {
+                return child
+            }
+        }
+
+        return null
+    }
+
+    // GuJS.toArray returns a array containing the items of the list, allowing
+    // nodes to be moved while iterating.
+    GuJS.toArray = function(list) {
+        var items = []
+        for (var i = 0; i < list.length; i++) {
+            items.push(list[i])
+        }
+
+        return items
+    }
+
     // addIfNoEqual adds a giving node into the target if its not found to match any
     // child nodes of the target and if one is found then that is replaced with the
     // provided new node.
@@ -1100,4 +1317,221 @@ function GuClient(onMessages, SendChannel) {
 
 
     onMessages(GuJS.ExecuteCommand)
+}
+
+// GuLive connects to a live driver serving an app at the provided url, executing
+// the commands received and sending events and changes of the page location to
+// the driver. The transports are tried in the order provided until one connects,
+// defaulting to WebSocket, Server-Sent Events and long-polling. When the
+// connection is lost the client reconnects with the same transport, resuming
+// its session on the driver.
+function GuLive(url, transports) {
+    transports = transports || ["websocket", "sse", "poll"]
+
+    var retryDelay = 1000
+    var listeners = []
+    var session = null
+    var sender = null
+    var chosen = 0
+
+    // received holds the sequence number of the last commands received by
+    // polling, acknowledging them with the next poll.
+    var received = 0
+
+    var current = function() {
+        return window.location.pathname + window.location.search + window.location.hash
+    }
+
+    // seed returns the seed of the app rendered into the page if any, so the
+    // app of the session is created with the keys of the page.
+    var seed = function() {
+        var meta = document.querySelector("meta[app-seed]")
+        return meta ? meta.getAttribute("app-seed") : ""
+    }
+
+    // address returns the url of the driver for the transport, containing the
+    // location and seed of the page and the session to be resumed.
+    var address = function(transport) {
+        var params = "transport=" + transport + "&location=" + encodeURIComponent(current())
+        if (session) {
+            params += "&session=" + encodeURIComponent(session)
+        }
+
+        if (seed()) {
+            params += "&seed=" + encodeURIComponent(seed())
+        }
+
+        return url + (url.indexOf("?") === -1 ? "?" : "&") + params
+    }
+
+    // socketAddress returns the WebSocket url for the address.
+    var socketAddress = function(target) {
+        if (target.indexOf("/") !== 0) {
+            return target.replace(/^http/, "ws")
+        }
+
+        var protocol = window.location.protocol === "https:" ? "wss://" : "ws://"
+        return protocol + window.location.host + target
+    }
+
+    // receive executes the command received from the driver, keeping the session
+    // sent by the driver to resume it when reconnecting.
+    var receive = function(command) {
+        if (typeof command === "string") {
+            command = JSON.parse(command)
+        }
+
+        if (command.Command === "Session") {
+            if (command.Session !== session) {
+                received = 0
+            }
+
+            session = command.Session
+            return
+        }
+
+        for (var i = 0; i < listeners.length; i++) {
+            listeners[i](command)
+        }
+    }
+
+    // post sends the data to the driver with a POST request, used by the
+    // transports which only receive commands.
+    var post = function(data) {
+        var request = new XMLHttpRequest()
+        request.open("POST", address("post"))
+        request.setRequestHeader("Content-Type", "application/json")
+        request.send(JSON.stringify(data))
+    }
+
+    var reconnect = function() {
+        sender = null
+        setTimeout(function() {
+            connectors[transports[chosen]](function() {}, reconnect)
+        }, retryDelay)
+    }
+
+    // connectors contains the functions connecting each transport, which call
+    // opened with the function sending data when connected, else failed.
+    var connectors = {
+        "websocket": function(opened, failed) {
+            if (!window.WebSocket) {
+                return failed()
+            }
+
+            var connected = false
+            var socket = new WebSocket(socketAddress(address("websocket")))
+
+            socket.onopen = function() {
+                connected = true
+                sender = function(data) {
+                    socket.send(JSON.stringify(data))
+                }
+                opened()
+            }
+
+            socket.onmessage = function(message) {
+                receive(message.data)
+            }
+
+            socket.onclose = function() {
+                connected ? reconnect() : failed()
+            }
+        },
+
+        "sse": function(opened, failed) {
+            if (!window.EventSource) {
+                return failed()
+            }
+
+            var connected = false
+            var source = new EventSource(address("sse"))
+
+            source.onopen = function() {
+                connected = true
+                sender = post
+                opened()
+            }
+
+            source.onmessage = function(message) {
+                receive(message.data)
+            }
+
+            source.onerror = function() {
+                source.close()
+                connected ? reconnect() : failed()
+            }
+        },
+
+        "poll": function(opened, failed) {
+            var connected = false
+
+            var poll = function() {
+                var request = new XMLHttpRequest()
+                request.open("GET", address("poll") + "&ack=" + received)
+
+                request.onload = function() {
+                    if (request.status === 410) {
+                        session = null
+                        received = 0
+                        return poll()
+                    }
+
+                    if (request.status !== 200) {
+                        return connected ? reconnect() : failed()
+                    }
+
+                    if (!connected) {
+                        connected = true
+                        sender = post
+                        opened()
+                    }
+
+                    var response = JSON.parse(request.responseText)
+                    for (var i = 0; i < response.Commands.length; i++) {
+                        receive(response.Commands[i])
+                    }
+
+                    received = response.Sequence
+                    poll()
+                }
+
+                request.onerror = function() {
+                    connected ? reconnect() : failed()
+                }
+
+                request.send()
+            }
+
+            poll()
+        }
+    }
+
+    // negotiate tries each transport in order until one connects.
+    var negotiate = function(index) {
+        if (index >= transports.length) {
+            return
+        }
+
+        chosen = index
+        connectors[transports[index]](function() {}, function() {
+            negotiate(index + 1)
+        })
+    }
+
+    window.addEventListener("popstate", function() {
+        if (sender) {
+            sender({ "type": "Location", "data": current() })
+        }
+    })
+
+    GuClient(function(listener) {
+        listeners.push(listener)
+    }, function(data) {
+        if (sender) {
+            sender(data)
+        }
+    })
+
+    negotiate(0)
 }`
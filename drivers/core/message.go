@@ -0,0 +1,56 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/trees"
+)
+
+// LocationMessage defines the type of the Message sent by core.js when the
+// location of the page changes, where the data of the message is the new url.
+const LocationMessage = "Location"
+
+// Message defines the json structure sent by core.js through the SendChannel of
+// a GuClient. For events Type is the name of the javascript event type e.g
+// MouseEvent, Meta the EventJSON of the registered event and Data the event
+// object.
+type Message struct {
+	Type string          `json:"type"`
+	Meta trees.EventJSON `json:"meta"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Location returns the url sent by a LocationMessage.
+func (m Message) Location() (string, error) {
+	if m.Type != LocationMessage {
+		return "", errors.New("Message is not a location message")
+	}
+
+	var location string
+	if err := json.Unmarshal(m.Data, &location); err != nil {
+		return "", err
+	}
+
+	return location, nil
+}
+
+// Broadcast returns the common.EventBroadcast for the event of the message,
+// which can be dispatched to the event handlers of the markup.
+func (m Message) Broadcast() (common.EventBroadcast, error) {
+	if m.Meta.EventID == "" {
+		return common.EventBroadcast{}, errors.New("Message has no event id")
+	}
+
+	event, err := GetEvent(m.Type, m.Data, nil)
+	if err != nil {
+		return common.EventBroadcast{}, err
+	}
+
+	return common.EventBroadcast{
+		EventName: m.Meta.EventName,
+		EventID:   m.Meta.EventID,
+		Event:     event,
+	}, nil
+}
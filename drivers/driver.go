@@ -1 +1,46 @@
+// Package drivers defines the interface implemented by drivers, which display
+// a gu.NApp and keep it in sync with the events of their display. The
+// drivertest package provides a conformance suite drivers run to prove they
+// implement the interface correctly.
 package drivers
+
+import (
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+)
+
+// Display defines the target a driver renders an app into, which applies the
+// gu.RenderCommand sent by the driver e.g core.js within a browser.
+type Display interface {
+	// Apply applies the rendering command to the display, where a RenderApp
+	// command replaces the app displayed, a RenderView command patches the
+	// view displayed with the same uid and a RenderComponent command patches
+	// the node of the view with the uid of the tree of the component.
+	Apply(gu.RenderCommand) error
+}
+
+// Driver defines the interface of drivers which display a gu.NApp. A driver
+// provides the location of the app, renders the app when mounted or when its
+// location changes, patches the views of the app as they update and delivers
+// the events of its display to the event handlers of the app.
+type Driver interface {
+	gu.Location
+
+	// Mount initializes the app with the driver as its location and sends a
+	// RenderApp command for the current location to the display. Views which
+	// become active through a RenderApp command are notified that they have
+	// been mounted. Until unmounted, an update of the app or a change of
+	// location sends a RenderApp command, an update of an active view sends
+	// a RenderView command for the view and an update of a component of an
+	// active view sends a RenderComponent command for the component.
+	Mount(app *gu.NApp, display Display) error
+
+	// Dispatch delivers the event from the display to the event handlers of
+	// the mounted app.
+	Dispatch(event common.EventBroadcast)
+
+	// Unmount stops the driver, notifying the active views of the app that
+	// they have been unmounted. No commands are sent to the display once it
+	// returns.
+	Unmount()
+}
@@ -0,0 +1,345 @@
+// Package drivertest provides a conformance suite for implementations of the
+// drivers.Driver interface, which renders an app through the driver into a
+// Recorder and asserts the commands sent for every rendering, event and
+// lifecycle path of the interface.
+//
+//	func TestConformance(t *testing.T) {
+//		drivertest.Run(t, func() drivers.Driver {
+//			return mydriver.New()
+//		})
+//	}
+package drivertest
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+)
+
+const (
+	success = "\u2713"
+	failed  = "\u2717"
+)
+
+// Timeout defines how long the suite waits for a command from a driver before
+// failing.
+var Timeout = 5 * time.Second
+
+// Quiet defines how long the suite waits to assert that a driver sends no
+// commands.
+var Quiet = 100 * time.Millisecond
+
+// Recorder defines a drivers.Display which records the commands applied to it
+// in order.
+type Recorder struct {
+	ml       sync.Mutex
+	commands []gu.RenderCommand
+	signal   chan struct{}
+}
+
+// NewRecorder returns a new instance of a Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		signal: make(chan struct{}, 1),
+	}
+}
+
+// Apply implements the drivers.Display interface, recording the command.
+func (r *Recorder) Apply(command gu.RenderCommand) error {
+	r.ml.Lock()
+	r.commands = append(r.commands, command)
+	r.ml.Unlock()
+
+	select {
+	case r.signal <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Next returns the oldest command not yet returned, waiting for a command
+// until the timeout elapses. It returns false if no command was applied.
+func (r *Recorder) Next(timeout time.Duration) (gu.RenderCommand, bool) {
+	deadline := time.After(timeout)
+
+	for {
+		r.ml.Lock()
+		if len(r.commands) > 0 {
+			command := r.commands[0]
+			r.commands = r.commands[1:]
+			r.ml.Unlock()
+
+			return command, true
+		}
+		r.ml.Unlock()
+
+		select {
+		case <-r.signal:
+		case <-deadline:
+			return gu.RenderCommand{}, false
+		}
+	}
+}
+
+// counter defines a component which counts the clicks on its button.
+type counter struct {
+	gu.Reactive
+	ml     sync.Mutex
+	clicks int
+}
+
+// Render implements the gu.Renderable interface.
+func (c *counter) Render() *trees.Markup {
+	c.ml.Lock()
+	clicks := c.clicks
+	c.ml.Unlock()
+
+	return elems.Button(
+		elems.Text("Clicks: %d", clicks),
+		events.ClickEvent(func() {
+			c.ml.Lock()
+			c.clicks++
+			c.ml.Unlock()
+
+			c.Publish()
+		}),
+	)
+}
+
+// fixture defines the app rendered by the suite, where the home view contains
+// a counter component and the about view contains only static markup.
+type fixture struct {
+	app    *gu.NApp
+	home   *gu.NView
+	about  *gu.NView
+	driver drivers.Driver
+	screen *Recorder
+}
+
+// mount returns a fixture mounted with a new driver, where the driver has
+// been navigated to the home view.
+func mount(t *testing.T, newDriver func() drivers.Driver) *fixture {
+	var f fixture
+
+	// apps with a unique seed are used, so the events dispatched to one app
+	// never reach the handlers of apps from other tests.
+	f.app = gu.SeededApp(trees.RandString(16), "Conformance", nil)
+	f.home = f.app.View(elems.Div(elems.Text("Home")), "/home", gu.BodyTarget)
+	f.home.Component(&counter{Reactive: gu.NewReactive()}, gu.AnyOrder, "*", "")
+	f.about = f.app.View(elems.Div(elems.Text("About")), "/about", gu.BodyTarget)
+
+	f.driver = newDriver()
+	f.screen = NewRecorder()
+
+	if err := f.driver.Mount(f.app, f.screen); err != nil {
+		t.Fatalf("\t%s\t  Should have mounted app: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have mounted app", success)
+
+	f.expect(t, "RenderApp")
+
+	f.driver.Navigate(router.PushDirectiveEvent{To: "/home"})
+	f.expectApp(t, f.home)
+
+	return &f
+}
+
+// expect returns the next command sent by the driver, failing if the command
+// has a different name.
+func (f *fixture) expect(t *testing.T, name string) gu.RenderCommand {
+	command, ok := f.screen.Next(Timeout)
+	if !ok {
+		t.Fatalf("\t%s\t  Should have received %s command", failed, name)
+	}
+
+	if command.Command != name {
+		t.Logf("\t\tRecieved: %#v\n", command)
+		t.Fatalf("\t%s\t  Should have received %s command", failed, name)
+	}
+	t.Logf("\t%s\t  Should have received %s command", success, name)
+
+	return command
+}
+
+// expectApp returns the next command sent by the driver, failing if the command
+// does not render the app with only the view provided.
+func (f *fixture) expectApp(t *testing.T, view *gu.NView) gu.AppJSON {
+	app := f.expect(t, "RenderApp").App
+
+	if app.AppID != f.app.UUID() {
+		t.Logf("\t\tRecieved: %q\n", app.AppID)
+		t.Fatalf("\t%s\t  Should have rendered app with its uid", failed)
+	}
+	t.Logf("\t%s\t  Should have rendered app with its uid", success)
+
+	if len(app.Body) != 1 || app.Body[0].ViewID != view.UUID() {
+		t.Logf("\t\tRecieved: %#v\n", app.Body)
+		t.Fatalf("\t%s\t  Should have rendered app with only the active view", failed)
+	}
+	t.Logf("\t%s\t  Should have rendered app with only the active view", success)
+
+	return app
+}
+
+// expectQuiet fails if the driver sends any command.
+func (f *fixture) expectQuiet(t *testing.T) {
+	if command, ok := f.screen.Next(Quiet); ok {
+		t.Logf("\t\tRecieved: %#v\n", command)
+		t.Fatalf("\t%s\t  Should have received no command", failed)
+	}
+	t.Logf("\t%s\t  Should have received no command", success)
+}
+
+// click dispatches a click on the first event of the view through the driver.
+func (f *fixture) click(t *testing.T, view gu.ViewJSON) {
+	if len(view.Tree.Events) == 0 {
+		t.Logf("\t\tRecieved: %#v\n", view.Tree)
+		t.Fatalf("\t%s\t  Should have rendered view with its events", failed)
+	}
+	t.Logf("\t%s\t  Should have rendered view with its events", success)
+
+	meta := view.Tree.Events[0]
+
+	f.driver.Dispatch(common.EventBroadcast{
+		EventName: meta.EventName,
+		EventID:   meta.EventID,
+		Event:     eventx.NewBaseEvent(&eventx.MouseEvent{}, nil),
+	})
+}
+
+// signal returns a channel which receives a value each time the function
+// returned is called, without blocking the caller.
+func signal() (chan struct{}, func()) {
+	received := make(chan struct{}, 16)
+
+	return received, func() {
+		select {
+		case received <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// wait fails if the channel receives no value within the Timeout.
+func wait(t *testing.T, received chan struct{}, message string) {
+	select {
+	case <-received:
+		t.Logf("\t%s\t  %s", success, message)
+	case <-time.After(Timeout):
+		t.Fatalf("\t%s\t  %s", failed, message)
+	}
+}
+
+// Run runs the conformance suite against the drivers returned by newDriver,
+// which must return a new driver which is not mounted for each call.
+func Run(t *testing.T, newDriver func() drivers.Driver) {
+	t.Run("RenderApp", func(t *testing.T) {
+		f := mount(t, newDriver)
+		defer f.driver.Unmount()
+
+		if location := f.driver.Location(); location.Path != "/home" {
+			t.Logf("\t\tRecieved: %#v\n", location)
+			t.Fatalf("\t%s\t  Should have provided location navigated to", failed)
+		}
+		t.Logf("\t%s\t  Should have provided location navigated to", success)
+
+		f.driver.Navigate(router.PushDirectiveEvent{To: "/about"})
+		f.expectApp(t, f.about)
+
+		f.app.UseThemeVariant(common.DarkVariant)
+
+		if app := f.expectApp(t, f.about); app.ThemeVariant != common.DarkVariant {
+			t.Fatalf("\t%s\t  Should have rendered app after update of app", failed)
+		}
+		t.Logf("\t%s\t  Should have rendered app after update of app", success)
+	})
+
+	t.Run("RenderView", func(t *testing.T) {
+		f := mount(t, newDriver)
+		defer f.driver.Unmount()
+
+		// updates of inactive views are not sent, so the first command is
+		// the update of the home view.
+		f.about.Publish()
+		f.home.Publish()
+		f.app.Scheduler().Flush()
+
+		if view := f.expect(t, "RenderView").View; view.ViewID != f.home.UUID() {
+			t.Logf("\t\tRecieved: %#v\n", view)
+			t.Fatalf("\t%s\t  Should have patched only the active view", failed)
+		}
+		t.Logf("\t%s\t  Should have patched only the active view", success)
+	})
+
+	t.Run("Dispatch", func(t *testing.T) {
+		f := mount(t, newDriver)
+		defer f.driver.Unmount()
+
+		f.home.Publish()
+		f.app.Scheduler().Flush()
+
+		view := f.expect(t, "RenderView").View
+
+		if !strings.Contains(view.Tree.Markup, "Clicks: 0") {
+			t.Logf("\t\tRecieved: %#v\n", view.Tree)
+			t.Fatalf("\t%s\t  Should have rendered view with component", failed)
+		}
+		t.Logf("\t%s\t  Should have rendered view with component", success)
+
+		f.click(t, view)
+
+		component := f.expect(t, "RenderComponent").View
+
+		if component.ViewID != f.home.UUID() || component.Tree.TreeID == view.Tree.TreeID || !strings.Contains(component.Tree.Markup, "Clicks: 1") {
+			t.Logf("\t\tRecieved: %#v\n", component)
+			t.Fatalf("\t%s\t  Should have patched only component after event", failed)
+		}
+		t.Logf("\t%s\t  Should have patched only component after event", success)
+
+		if strings.Contains(component.Tree.Markup, "Home") {
+			t.Logf("\t\tRecieved: %#v\n", component.Tree)
+			t.Fatalf("\t%s\t  Should have rendered only markup of component", failed)
+		}
+		t.Logf("\t%s\t  Should have rendered only markup of component", success)
+	})
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		f := mount(t, newDriver)
+
+		mounted, onMounted := signal()
+		f.about.Services().Mounted.React(onMounted)
+
+		f.driver.Navigate(router.PushDirectiveEvent{To: "/about"})
+		f.expectApp(t, f.about)
+
+		wait(t, mounted, "Should have mounted view which became active")
+
+		unmounted, onUnmounted := signal()
+		f.about.Services().Unmounted.React(onUnmounted)
+
+		f.driver.Unmount()
+
+		select {
+		case <-unmounted:
+			t.Logf("\t%s\t  Should have unmounted active view once unmounted", success)
+		default:
+			t.Fatalf("\t%s\t  Should have unmounted active view once unmounted", failed)
+		}
+
+		f.about.Publish()
+		f.app.Scheduler().Flush()
+		f.app.UseThemeVariant(common.DarkVariant)
+		f.expectQuiet(t)
+	})
+}
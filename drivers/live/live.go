@@ -0,0 +1,226 @@
+// Package live provides a driver which runs a gu.NApp on the server for each
+// client session, sending rendering commands to core.js in the browser and
+// delivering the events it sends back to the app, so apps work without a
+// GopherJS build. Clients connect through one of the transports of the Handler,
+// which are WebSockets, Server-Sent Events and long-polling, and resume their
+// session when they reconnect.
+package live
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+)
+
+// contains the names of the transports provided by a Handler.
+const (
+	WebSocket   = "websocket"
+	EventSource = "sse"
+	Polling     = "poll"
+)
+
+// contains sizes of the random values generated for sessions.
+const (
+	seedSize      = 16
+	sessionIDSize = 32
+)
+
+// DefaultTimeout defines the default duration a session is kept after its client
+// disconnects.
+const DefaultTimeout = 30 * time.Second
+
+// AppFactory defines a function which returns the app served to a session,
+// created with gu.SeededApp from the provided seed. The seed is the seed of the
+// app rendered into the page of the client when the page has one, so the keys
+// of the session's app match the html the client adopts, else a random seed.
+type AppFactory func(seed string, r *http.Request) *gu.NApp
+
+// Transport defines a means of connecting a client to its session, where the
+// rendering commands of the session are sent to the client and the messages
+// of the client are delivered to the session.
+type Transport interface {
+	// Serve serves the session to the client of the request, returning when
+	// the client disconnects.
+	Serve(w http.ResponseWriter, r *http.Request, session *Session)
+}
+
+// Handler defines a http.Handler which serves a new app for each client
+// session, where the app is rendered for the location sent by the client and
+// kept in sync as the app updates.
+//
+// Clients select a transport with the transport query parameter and resume
+// a session with the session query parameter, while the location of the page
+// is read from the location query parameter and the seed of the app rendered
+// into the page from the seed query parameter. Transports which only send
+// commands receive the messages of clients through POST requests.
+type Handler struct {
+	factory AppFactory
+
+	ml       sync.Mutex
+	sessions map[string]*Session
+
+	// Transports contains the transports clients can connect with keyed by
+	// their name.
+	Transports map[string]Transport
+
+	// Timeout defines how long a session is kept after its client disconnects,
+	// allowing the client to reconnect and resume the session.
+	Timeout time.Duration
+}
+
+// New returns a new Handler which serves the apps returned by the factory over
+// the WebSocket, EventSource and Polling transports.
+func New(factory AppFactory) *Handler {
+	return &Handler{
+		factory:  factory,
+		sessions: make(map[string]*Session),
+		Timeout:  DefaultTimeout,
+		Transports: map[string]Transport{
+			WebSocket:   &WebSocketTransport{},
+			EventSource: &EventSourceTransport{},
+			Polling:     &PollingTransport{Timeout: DefaultPollTimeout},
+		},
+	}
+}
+
+// ServeHTTP implements the http.Handler interface, serving the session of the
+// client over the transport requested, or delivering the messages posted by
+// the client into its session.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost {
+		h.receive(w, r)
+		return
+	}
+
+	name := r.URL.Query().Get("transport")
+	if name == "" {
+		name = WebSocket
+	}
+
+	transport, ok := h.Transports[name]
+	if !ok {
+		http.Error(w, "Unknown transport", http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.attach(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	defer h.detach(session)
+
+	transport.Serve(w, r, session)
+}
+
+// Session returns the session with the provided id if it exists.
+func (h *Handler) Session(id string) (*Session, bool) {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	session, ok := h.sessions[id]
+	return session, ok
+}
+
+// receive delivers the message posted by a client into its session.
+func (h *Handler) receive(w http.ResponseWriter, r *http.Request) {
+	session, ok := h.Session(r.URL.Query().Get("session"))
+	if !ok {
+		http.Error(w, "Unknown session", http.StatusGone)
+		return
+	}
+
+	var message core.Message
+	if err := json.NewDecoder(r.Body).Decode(&message); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	session.Deliver(message)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// attach returns the session requested by the client, creating a new session
+// when the client has none or its session has expired.
+func (h *Handler) attach(r *http.Request) (*Session, error) {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	if session, ok := h.sessions[r.URL.Query().Get("session")]; ok {
+		session.attach()
+		return session, nil
+	}
+
+	location := r.URL.Query().Get("location")
+	if location == "" {
+		location = "/"
+	}
+
+	pe, err := router.NewPushEvent(location, false)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := r.URL.Query().Get("seed")
+	if seed == "" {
+		seed = trees.RandString(seedSize)
+	}
+
+	session := NewSession(trees.RandString(sessionIDSize), pe)
+	if err := session.Mount(h.factory(seed, r), session); err != nil {
+		return nil, err
+	}
+
+	session.attach()
+
+	h.sessions[session.id] = session
+
+	return session, nil
+}
+
+// detach detaches a client from the session, closing the session if no client
+// attaches to it within the timeout of the handler.
+func (h *Handler) detach(session *Session) {
+	if session.detach() > 0 {
+		return
+	}
+
+	time.AfterFunc(h.Timeout, func() {
+		h.ml.Lock()
+		defer h.ml.Unlock()
+
+		if !session.idle(h.Timeout) {
+			return
+		}
+
+		delete(h.sessions, session.id)
+		session.Close()
+	})
+}
+
+// Script returns a script markup which connects the page to a Handler served
+// at the provided url, to be added into an app with NApp.AddAsset. The
+// transports are tried in the order provided, defaulting to WebSocket,
+// EventSource and Polling.
+func Script(url string, transports ...string) *trees.Markup {
+	if len(transports) == 0 {
+		transports = []string{WebSocket, EventSource, Polling}
+	}
+
+	quotedURL, _ := json.Marshal(url)
+	quotedTransports, _ := json.Marshal(transports)
+
+	script := trees.NewMarkup("script", false)
+	trees.NewAttr("type", "text/javascript").Apply(script)
+	trees.NewText("GuLive(%s, %s);", quotedURL, quotedTransports).Apply(script)
+
+	return script
+}
@@ -0,0 +1,522 @@
+package live_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers"
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/drivers/drivertest"
+	"github.com/gu-io/gu/drivers/live"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/influx6/faux/tests"
+)
+
+type counter struct {
+	gu.Reactive
+	clicks int
+}
+
+func (c *counter) Render() *trees.Markup {
+	return elems.Button(
+		elems.Text("Clicks: %d", c.clicks),
+		events.ClickEvent(func() {
+			c.clicks++
+			c.Publish()
+		}),
+	)
+}
+
+// command defines the fields of the commands sent by the driver.
+type command struct {
+	gu.RenderCommand
+	Session string `json:"Session"`
+}
+
+func newApp(seed string, r *http.Request) *gu.NApp {
+	app := gu.SeededApp(seed, "Live Test", nil)
+
+	view := app.View(elems.Div(elems.Text("Welcome Home")), "/home", gu.BodyTarget)
+	view.Component(&counter{Reactive: gu.NewReactive()}, gu.AnyOrder, "*", "")
+
+	return app
+}
+
+func newHandler() *live.Handler {
+	return live.New(newApp)
+}
+
+// click returns the message for a click on the button of the rendered app.
+func click(t *testing.T, app gu.AppJSON) core.Message {
+	if len(app.Body) != 1 {
+		t.Logf("\t\tRecieved: %#v\n", app)
+		tests.Failed("Should have rendered app with matching view")
+	}
+	tests.Passed("Should have rendered app with matching view")
+
+	tree := app.Body[0].Tree
+	if !strings.Contains(tree.Markup, "Clicks: 0") || len(tree.Events) != 1 {
+		t.Logf("\t\tRecieved: %#v\n", tree)
+		tests.Failed("Should have rendered component with its click event")
+	}
+	tests.Passed("Should have rendered component with its click event")
+
+	return core.Message{Type: "MouseEvent", Meta: tree.Events[0], Data: []byte("{}")}
+}
+
+func dial(t *testing.T, server *httptest.Server, query string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/?" + query
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		tests.Failed("Should have connected to live driver: %+q", err)
+	}
+	tests.Passed("Should have connected to live driver")
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return conn
+}
+
+func read(t *testing.T, conn *websocket.Conn, name string) command {
+	var received command
+	if err := conn.ReadJSON(&received); err != nil {
+		tests.Failed("Should have received %s command: %+q", name, err)
+	}
+
+	if received.Command != name {
+		t.Logf("\t\tRecieved: %#v\n", received)
+		tests.Failed("Should have received %s command", name)
+	}
+	tests.Passed("Should have received %s command", name)
+
+	return received
+}
+
+func TestWebSocketTransport(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	conn := dial(t, server, "location=/home")
+	defer conn.Close()
+
+	read(t, conn, live.SessionCommand)
+	message := click(t, read(t, conn, "RenderApp").App)
+
+	if err := conn.WriteJSON(message); err != nil {
+		tests.Failed("Should have sent click event: %+q", err)
+	}
+	tests.Passed("Should have sent click event")
+
+	if received := read(t, conn, "RenderComponent"); !strings.Contains(received.View.Tree.Markup, "Clicks: 1") {
+		t.Logf("\t\tRecieved: %#v\n", received)
+		tests.Failed("Should have rendered component updated by click event")
+	}
+	tests.Passed("Should have rendered component updated by click event")
+}
+
+func TestSessionSeed(t *testing.T) {
+	page := newApp("page-seed", nil)
+
+	pe, err := router.NewPushEvent("/home", false)
+	if err != nil {
+		tests.Failed("Should have created push event: %+q", err)
+	}
+
+	rc, err := page.RenderFor(context.Background(), pe)
+	if err != nil {
+		tests.Failed("Should have rendered page: %+q", err)
+	}
+
+	if !strings.Contains(rc.Markup.HTML(), `app-seed="page-seed"`) {
+		tests.Failed("Should have rendered seed of app into page")
+	}
+	tests.Passed("Should have rendered seed of app into page")
+
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	conn := dial(t, server, "location=/home&seed=page-seed")
+	defer conn.Close()
+
+	read(t, conn, live.SessionCommand)
+	app := read(t, conn, "RenderApp").App
+
+	if app.AppID != page.UUID() || len(app.Body) != 1 || app.Body[0].ViewID != rc.Views[0].UUID() {
+		t.Logf("\t\tRecieved: %q\n", app.AppID)
+		t.Logf("\t\tExpected: %q\n", page.UUID())
+		tests.Failed("Should have created session app with the keys of the page")
+	}
+	tests.Passed("Should have created session app with the keys of the page")
+}
+
+func TestSessionIsolation(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	first := dial(t, server, "location=/home&seed=page-seed")
+	defer first.Close()
+
+	second := dial(t, server, "location=/home&seed=page-seed")
+	defer second.Close()
+
+	read(t, first, live.SessionCommand)
+	message := click(t, read(t, first, "RenderApp").App)
+
+	read(t, second, live.SessionCommand)
+	read(t, second, "RenderApp")
+
+	if err := first.WriteJSON(message); err != nil {
+		tests.Failed("Should have sent click event: %+q", err)
+	}
+	tests.Passed("Should have sent click event")
+
+	if received := read(t, first, "RenderComponent"); !strings.Contains(received.View.Tree.Markup, "Clicks: 1") {
+		t.Logf("\t\tRecieved: %#v\n", received)
+		tests.Failed("Should have rendered component updated by click event")
+	}
+	tests.Passed("Should have rendered component updated by click event")
+
+	second.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+
+	var received command
+	if err := second.ReadJSON(&received); err == nil {
+		t.Logf("\t\tRecieved: %#v\n", received)
+		tests.Failed("Should have delivered click event only to the session of the client")
+	}
+	tests.Passed("Should have delivered click event only to the session of the client")
+}
+
+func TestSessionResume(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	conn := dial(t, server, "location=/home")
+	session := read(t, conn, live.SessionCommand).Session
+	message := click(t, read(t, conn, "RenderApp").App)
+	conn.Close()
+
+	conn = dial(t, server, "location=/home&session="+session)
+	defer conn.Close()
+
+	if err := conn.WriteJSON(message); err != nil {
+		tests.Failed("Should have sent click event: %+q", err)
+	}
+	tests.Passed("Should have sent click event")
+
+	if received := read(t, conn, "RenderComponent"); !strings.Contains(received.View.Tree.Markup, "Clicks: 1") {
+		t.Logf("\t\tRecieved: %#v\n", received)
+		tests.Failed("Should have resumed session without rendering the app")
+	}
+	tests.Passed("Should have resumed session without rendering the app")
+}
+
+func TestEventSourceTransport(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/?transport=sse&location=/home")
+	if err != nil {
+		tests.Failed("Should have connected to live driver: %+q", err)
+	}
+	tests.Passed("Should have connected to live driver")
+
+	defer res.Body.Close()
+
+	if res.Header.Get("Content-Type") != "text/event-stream" {
+		tests.Failed("Should have received an event stream: %q", res.Header.Get("Content-Type"))
+	}
+	tests.Passed("Should have received an event stream")
+
+	reader := bufio.NewReader(res.Body)
+
+	next := func(name string) command {
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				tests.Failed("Should have received %s command: %+q", name, err)
+			}
+
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+
+			var received command
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &received); err != nil || received.Command != name {
+				t.Logf("\t\tRecieved: %q\n", line)
+				tests.Failed("Should have received %s command", name)
+			}
+			tests.Passed("Should have received %s command", name)
+
+			return received
+		}
+	}
+
+	session := next(live.SessionCommand).Session
+	post(t, server, session, click(t, next("RenderApp").App))
+
+	if received := next("RenderComponent"); !strings.Contains(received.View.Tree.Markup, "Clicks: 1") {
+		t.Logf("\t\tRecieved: %#v\n", received)
+		tests.Failed("Should have rendered component updated by click event")
+	}
+	tests.Passed("Should have rendered component updated by click event")
+}
+
+func TestPollingTransport(t *testing.T) {
+	server := httptest.NewServer(newHandler())
+	defer server.Close()
+
+	var session string
+	var sequence int
+	var received []command
+
+	poll := func(name string) command {
+		for attempt := 0; attempt < 5; attempt++ {
+			if len(received) > 0 {
+				next := received[0]
+				received = received[1:]
+
+				if next.Command != name {
+					t.Logf("\t\tRecieved: %#v\n", next)
+					tests.Failed("Should have received %s command", name)
+				}
+				tests.Passed("Should have received %s command", name)
+
+				return next
+			}
+
+			response := pollCommands(t, server, session, sequence)
+			received, sequence = response.Commands, response.Sequence
+		}
+
+		tests.Failed("Should have received %s command", name)
+		return command{}
+	}
+
+	session = poll(live.SessionCommand).Session
+	post(t, server, session, click(t, poll("RenderApp").App))
+
+	acked := sequence
+
+	if next := poll("RenderComponent"); !strings.Contains(next.View.Tree.Markup, "Clicks: 1") {
+		t.Logf("\t\tRecieved: %#v\n", next)
+		tests.Failed("Should have rendered component updated by click event")
+	}
+	tests.Passed("Should have rendered component updated by click event")
+
+	// Polling without acknowledging the last commands, as when their response
+	// never reached the client, sends them again.
+	resent := pollCommands(t, server, session, acked)
+	if len(resent.Commands) != 1 || resent.Commands[0].Command != "RenderComponent" || resent.Sequence != sequence {
+		t.Logf("\t\tRecieved: %#v\n", resent)
+		tests.Failed("Should have sent unacknowledged commands again")
+	}
+	tests.Passed("Should have sent unacknowledged commands again")
+}
+
+// polled defines the json response of a poll.
+type polled struct {
+	Sequence int       `json:"Sequence"`
+	Commands []command `json:"Commands"`
+}
+
+// pollCommands polls the live driver for the commands of the session,
+// acknowledging the commands up to the sequence.
+func pollCommands(t *testing.T, server *httptest.Server, session string, ack int) polled {
+	res, err := http.Get(server.URL + "/?transport=poll&location=/home&session=" + session + "&ack=" + strconv.Itoa(ack))
+	if err != nil {
+		tests.Failed("Should have polled live driver: %+q", err)
+	}
+
+	defer res.Body.Close()
+
+	var response polled
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		tests.Failed("Should have received commands: %+q", err)
+	}
+
+	return response
+}
+
+func post(t *testing.T, server *httptest.Server, session string, message core.Message) {
+	data, _ := json.Marshal(message)
+
+	res, err := http.Post(server.URL+"/?transport=post&session="+session, "application/json", bytes.NewReader(data))
+	if err != nil {
+		tests.Failed("Should have posted click event: %+q", err)
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		tests.Failed("Should have delivered click event: %d", res.StatusCode)
+	}
+	tests.Passed("Should have delivered click event")
+}
+
+func TestConformance(t *testing.T) {
+	drivertest.Run(t, func() drivers.Driver {
+		pe, _ := router.NewPushEvent("/", false)
+		return live.NewSession("conformance", pe)
+	})
+}
+
+// documentStub defines a document for running the core driver in node, whose
+// head and body track the event listeners registered on them.
+const documentStub = `
+function element() {
+	return {
+		listeners: [],
+		childNodes: [],
+		innerHTML: "",
+		addEventListener: function(type, callback, capture) {
+			this.listeners.push({type: type, callback: callback, capture: !!capture})
+		},
+		removeEventListener: function(type, callback, capture) {
+			this.listeners = this.listeners.filter(function(listener) {
+				return !(listener.type === type && listener.callback === callback && listener.capture === !!capture)
+			})
+		},
+		querySelector: function() { return null },
+		querySelectorAll: function() { return [] },
+		hasChildNodes: function() { return false },
+		appendChild: function() {},
+	}
+}
+
+var head = element()
+var body = element()
+
+var document = {
+	querySelector: function(selector) {
+		switch (selector) {
+		case "head":
+			return head
+		case "body":
+			return body
+		}
+
+		return null
+	},
+	createElement: function() { return element() },
+	createDocumentFragment: function() { return element() },
+}
+`
+
+// runCommands runs the commands through the core driver in node, returning the
+// number of event listeners left registered on the body.
+func runCommands(t *testing.T, node string, commands []json.RawMessage) int {
+	data, err := json.Marshal(commands)
+	if err != nil {
+		tests.Failed("Should have encoded commands: %+q", err)
+	}
+
+	dir, err := ioutil.TempDir("", "gu-live")
+	if err != nil {
+		tests.Failed("Should have created script directory: %+q", err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	script := documentStub + core.JavascriptDriverCore + `
+var execute
+GuClient(function(fn) { execute = fn }, function() {})
+
+var commands = ` + string(data) + `
+commands.forEach(function(command) { execute(command) })
+
+process.stdout.write(String(body.listeners.length))
+`
+
+	path := filepath.Join(dir, "driver.js")
+	if err := ioutil.WriteFile(path, []byte(script), 0644); err != nil {
+		tests.Failed("Should have written driver script: %+q", err)
+	}
+
+	out, err := exec.Command(node, path).CombinedOutput()
+	if err != nil {
+		t.Logf("\t\tRecieved: %s\n", out)
+		tests.Failed("Should have executed commands in core driver: %+q", err)
+	}
+	tests.Passed("Should have executed commands in core driver")
+
+	listeners, err := strconv.Atoi(string(out))
+	if err != nil {
+		t.Logf("\t\tRecieved: %s\n", out)
+		tests.Failed("Should have reported registered listeners: %+q", err)
+	}
+
+	return listeners
+}
+
+func TestRenderViewEvents(t *testing.T) {
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node is required to run the core driver")
+	}
+
+	views := make(chan *gu.NView, 1)
+	server := httptest.NewServer(live.New(func(seed string, r *http.Request) *gu.NApp {
+		app := newApp(seed, r)
+		views <- app.Views()[0]
+		return app
+	}))
+	defer server.Close()
+
+	conn := dial(t, server, "location=/home")
+	defer conn.Close()
+
+	read(t, conn, live.SessionCommand)
+	read(t, conn, "RenderApp")
+
+	view := <-views
+
+	var commands []json.RawMessage
+	var events int
+
+	for index := 0; index < 2; index++ {
+		view.Publish()
+
+		var data json.RawMessage
+		if err := conn.ReadJSON(&data); err != nil {
+			tests.Failed("Should have received RenderView command: %+q", err)
+		}
+
+		var received command
+		if err := json.Unmarshal(data, &received); err != nil || received.Command != "RenderView" {
+			t.Logf("\t\tRecieved: %s\n", data)
+			tests.Failed("Should have received RenderView command")
+		}
+		tests.Passed("Should have received RenderView command")
+
+		commands = append(commands, data)
+		events = len(received.View.Tree.Events)
+	}
+
+	if events == 0 {
+		tests.Failed("Should have rendered view with events")
+	}
+	tests.Passed("Should have rendered view with events")
+
+	if listeners := runCommands(t, node, commands); listeners != events {
+		t.Logf("\t\tRecieved: %d listeners for %d events\n", listeners, events)
+		tests.Failed("Should have registered only the events of the last render")
+	}
+	tests.Passed("Should have registered only the events of the last render")
+}
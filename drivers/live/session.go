@@ -0,0 +1,458 @@
+package live
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers"
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/router"
+)
+
+// SessionCommand defines the command sent to a client when its session is
+// created, providing the id the client uses to resume the session.
+const SessionCommand = "Session"
+
+// sessionCommand defines the json structure of the SessionCommand.
+type sessionCommand struct {
+	Command string `json:"Command"`
+	Session string `json:"Session"`
+}
+
+// Session defines the state of a client, which is its app and the rendering
+// commands yet to be sent to the client. Sessions outlive the connections of
+// their client, so a client which reconnects continues from the commands it
+// missed without the app being rendered again. The events received and the
+// updates of the app are processed in order by a single goroutine.
+//
+// Session implements the drivers.Driver interface, where the Handler mounts
+// the app of the session with the session as its display, which queues the
+// commands for the client.
+type Session struct {
+	id string
+
+	ml       sync.Mutex
+	app      *gu.NApp
+	current  router.PushEvent
+	pending  []interface{}
+	outbox   [][]byte
+	unacked  [][]byte
+	acked    int
+	ready    chan struct{}
+	attached int
+	lastSeen time.Time
+	closed   bool
+
+	signal  chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	stopped sync.Once
+	once    sync.Once
+}
+
+// NewSession returns a new session with the provided id at the location, which
+// renders its app once mounted.
+func NewSession(id string, pe router.PushEvent) *Session {
+	session := &Session{
+		id:       id,
+		current:  pe,
+		ready:    make(chan struct{}),
+		lastSeen: time.Now(),
+		signal:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	session.send(sessionCommand{Command: SessionCommand, Session: id})
+	session.queue(pe)
+
+	return session
+}
+
+// ID returns the id of the session.
+func (s *Session) ID() string {
+	return s.id
+}
+
+// App returns the app mounted into the session.
+func (s *Session) App() *gu.NApp {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	return s.app
+}
+
+// Mount implements the drivers.Driver interface, rendering the app into the
+// display as it updates until the session is unmounted.
+func (s *Session) Mount(app *gu.NApp, display drivers.Display) error {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	if s.app != nil {
+		return errors.New("Session already has a mounted app")
+	}
+
+	if s.closed {
+		return errors.New("Session is closed")
+	}
+
+	s.app = app
+
+	app.InitApp(s)
+
+	go s.run(app, display)
+
+	return nil
+}
+
+// Unmount implements the drivers.Driver interface, stopping the processing of
+// the session and notifying the active views of its app that they have been
+// unmounted.
+func (s *Session) Unmount() {
+	s.stopped.Do(func() {
+		close(s.stop)
+	})
+
+	s.ml.Lock()
+	mounted := s.app != nil
+	s.ml.Unlock()
+
+	if mounted {
+		<-s.done
+	}
+}
+
+// Dispatch implements the drivers.Driver interface, queuing the event to be
+// delivered to the event handlers of the app.
+func (s *Session) Dispatch(event common.EventBroadcast) {
+	s.queue(event)
+}
+
+// Apply implements the drivers.Display interface, queuing the command to be
+// sent to the client.
+func (s *Session) Apply(command gu.RenderCommand) error {
+	return s.send(command)
+}
+
+// Location implements the gu.Location interface, returning the current
+// location of the session.
+func (s *Session) Location() router.PushEvent {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	return s.current
+}
+
+// Navigate implements the gu.Location interface, rendering the app for the
+// provided path.
+func (s *Session) Navigate(pd router.PushDirectiveEvent) {
+	pe, err := router.NewPushEvent(pd.To, false)
+	if err != nil {
+		return
+	}
+
+	s.ml.Lock()
+	s.current = pe
+	s.ml.Unlock()
+
+	s.queue(pe)
+}
+
+// Deliver queues the message of the client to be delivered to the app, where
+// a location message navigates the app and other messages are dispatched as
+// events.
+func (s *Session) Deliver(message core.Message) {
+	if message.Type == core.LocationMessage {
+		if location, err := message.Location(); err == nil {
+			s.Navigate(router.PushDirectiveEvent{To: location})
+		}
+
+		return
+	}
+
+	if broadcast, err := message.Broadcast(); err == nil {
+		s.Dispatch(broadcast)
+	}
+}
+
+// Next returns the rendering commands to be sent to the client as json,
+// waiting for commands until done is closed. It returns false if the session
+// is closed.
+func (s *Session) Next(done <-chan struct{}) ([][]byte, bool) {
+	for {
+		s.ml.Lock()
+
+		if len(s.outbox) > 0 {
+			commands := s.outbox
+			s.outbox = nil
+			s.ml.Unlock()
+
+			return commands, true
+		}
+
+		if s.closed {
+			s.ml.Unlock()
+			return nil, false
+		}
+
+		ready := s.ready
+		s.ml.Unlock()
+
+		select {
+		case <-ready:
+		case <-done:
+			return nil, true
+		}
+	}
+}
+
+// Poll returns the rendering commands to be sent to a polling client as json
+// with the sequence number of the last of them, waiting for commands until done
+// is closed. The client acknowledges the commands it received by sending the
+// sequence number of the last of them with its next poll, where the commands of
+// earlier polls which were not acknowledged are sent again, so commands are not
+// lost when a response fails to reach the client. It returns false if the
+// session is closed.
+func (s *Session) Poll(ack int, done <-chan struct{}) ([][]byte, int, bool) {
+	s.ml.Lock()
+
+	if confirmed := ack - s.acked; confirmed > 0 {
+		if confirmed > len(s.unacked) {
+			confirmed = len(s.unacked)
+		}
+
+		s.unacked = s.unacked[confirmed:]
+		s.acked += confirmed
+	}
+
+	if len(s.unacked) > 0 {
+		s.outbox = append(append([][]byte{}, s.unacked...), s.outbox...)
+		s.unacked = nil
+	}
+
+	s.ml.Unlock()
+
+	commands, ok := s.Next(done)
+	if !ok {
+		return nil, 0, false
+	}
+
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.unacked = commands
+
+	return commands, s.acked + len(commands), true
+}
+
+// Requeue returns commands retrieved with Next which could not be sent to the
+// client, so they are sent when the client reconnects.
+func (s *Session) Requeue(commands [][]byte) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.outbox = append(append([][]byte{}, commands...), s.outbox...)
+}
+
+// Close unmounts the session and releases clients waiting for its commands.
+func (s *Session) Close() {
+	s.Unmount()
+
+	s.once.Do(func() {
+		s.ml.Lock()
+		s.closed = true
+		close(s.ready)
+		s.ml.Unlock()
+	})
+}
+
+// attach records a client attached to the session.
+func (s *Session) attach() {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.attached++
+}
+
+// detach records a client detached from the session, returning the number of
+// clients still attached.
+func (s *Session) detach() int {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.attached--
+	s.lastSeen = time.Now()
+
+	return s.attached
+}
+
+// idle returns true/false if no client has been attached to the session for
+// the provided duration.
+func (s *Session) idle(timeout time.Duration) bool {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	return s.attached == 0 && time.Since(s.lastSeen) >= timeout
+}
+
+// queue adds the message or update into the pending items and signals the
+// session.
+func (s *Session) queue(item interface{}) {
+	s.ml.Lock()
+	s.pending = append(s.pending, item)
+	s.ml.Unlock()
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// send adds the json of the command into the outbox, waking clients waiting
+// for commands.
+func (s *Session) send(command interface{}) error {
+	data, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	if s.closed {
+		return errors.New("Session is closed")
+	}
+
+	s.outbox = append(s.outbox, data)
+
+	close(s.ready)
+	s.ready = make(chan struct{})
+
+	return nil
+}
+
+// run processes the events and updates of the app until the session is
+// unmounted.
+func (s *Session) run(app *gu.NApp, display drivers.Display) {
+	defer close(s.done)
+
+	appUpdates := notifications.SubscribeWithRemover(gu.NewAppUpdateHandler(func(update gu.AppUpdate) {
+		if update.App == app {
+			s.queue(update)
+		}
+	}))
+	defer appUpdates.Remove()
+
+	viewUpdates := notifications.SubscribeWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		if update.App == app {
+			s.queue(update)
+		}
+	}))
+	defer viewUpdates.Remove()
+
+	componentUpdates := notifications.SubscribeWithRemover(gu.NewComponentUpdateHandler(func(update gu.ComponentUpdate) {
+		if update.App == app {
+			s.queue(update)
+		}
+	}))
+	defer componentUpdates.Remove()
+
+	for {
+		select {
+		case <-s.signal:
+		case <-s.stop:
+			for _, view := range app.ActiveViews() {
+				view.Unmounted()
+			}
+
+			return
+		}
+
+		s.process(app, display)
+	}
+}
+
+// process delivers the pending events and sends the rendering commands for the
+// pending updates, where an update of the app or a change of location renders
+// the app once, updates of views render each view once and updates of
+// components render each component once unless its view is rendered. Views
+// which become active through the rendering of the app are notified they are
+// mounted.
+func (s *Session) process(app *gu.NApp, display drivers.Display) {
+	s.ml.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.ml.Unlock()
+
+	var renderApp bool
+	var route interface{}
+	var views []*gu.NView
+	var components []gu.ComponentUpdate
+
+	seen := make(map[*gu.NView]bool)
+	seenComponents := make(map[*gu.Component]bool)
+
+	for _, item := range pending {
+		switch update := item.(type) {
+		case common.EventBroadcast:
+			app.Deliver(update)
+		case router.PushEvent:
+			renderApp = true
+			route = update
+		case gu.AppUpdate:
+			renderApp = true
+		case gu.ViewUpdate:
+			if !seen[update.View] {
+				seen[update.View] = true
+				views = append(views, update.View)
+			}
+		case gu.ComponentUpdate:
+			if !seenComponents[update.Component] {
+				seenComponents[update.Component] = true
+				components = append(components, update)
+			}
+		}
+	}
+
+	if renderApp {
+		previous := app.ActiveViews()
+
+		display.Apply(gu.AppRenderCommand(app, route))
+
+		for _, view := range app.ActiveViews() {
+			if !contains(previous, view) {
+				view.Mounted()
+			}
+		}
+
+		return
+	}
+
+	for _, view := range views {
+		if contains(app.ActiveViews(), view) {
+			display.Apply(gu.ViewRenderCommand(view))
+		}
+	}
+
+	for _, update := range components {
+		if !seen[update.View] && contains(app.ActiveViews(), update.View) {
+			display.Apply(gu.ComponentRenderCommand(update.Component))
+		}
+	}
+}
+
+// contains returns true/false if the view is within the views.
+func contains(views []*gu.NView, view *gu.NView) bool {
+	for _, item := range views {
+		if item == view {
+			return true
+		}
+	}
+
+	return false
+}
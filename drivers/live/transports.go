@@ -0,0 +1,170 @@
+package live
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/gu-io/gu/drivers/core"
+)
+
+// DefaultPollTimeout defines the default duration a poll waits for commands
+// before responding with none.
+const DefaultPollTimeout = 25 * time.Second
+
+// WebSocketTransport defines a Transport which sends commands and receives
+// messages over a WebSocket connection.
+type WebSocketTransport struct {
+	// Upgrader is used to upgrade requests into WebSocket connections.
+	Upgrader websocket.Upgrader
+}
+
+// Serve implements the Transport interface.
+func (t *WebSocketTransport) Serve(w http.ResponseWriter, r *http.Request, session *Session) {
+	conn, err := t.Upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	defer conn.Close()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for {
+			var message core.Message
+			if err := conn.ReadJSON(&message); err != nil {
+				return
+			}
+
+			session.Deliver(message)
+		}
+	}()
+
+	for {
+		commands, ok := session.Next(done)
+		if !ok {
+			return
+		}
+
+		for index, command := range commands {
+			if err := conn.WriteMessage(websocket.TextMessage, command); err != nil {
+				session.Requeue(commands[index:])
+				return
+			}
+		}
+
+		select {
+		case <-done:
+			return
+		default:
+		}
+	}
+}
+
+// EventSourceTransport defines a Transport which sends commands as Server-Sent
+// Events, where the messages of the client are received through POST requests
+// to the Handler.
+type EventSourceTransport struct{}
+
+// Serve implements the Transport interface.
+func (t *EventSourceTransport) Serve(w http.ResponseWriter, r *http.Request, session *Session) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	done := r.Context().Done()
+
+	for {
+		commands, ok := session.Next(done)
+		if !ok {
+			return
+		}
+
+		for index, command := range commands {
+			if _, err := w.Write(event(command)); err != nil {
+				session.Requeue(commands[index:])
+				return
+			}
+		}
+
+		flusher.Flush()
+
+		if r.Context().Err() != nil {
+			return
+		}
+	}
+}
+
+// event returns the Server-Sent Event containing the command, the json of
+// commands contains no new lines so it fits within a single data field.
+func event(command []byte) []byte {
+	var content bytes.Buffer
+	content.WriteString("data: ")
+	content.Write(command)
+	content.WriteString("\n\n")
+	return content.Bytes()
+}
+
+// PollingTransport defines a Transport which sends commands in response to
+// long-polling requests, where each request waits for commands and responds
+// with a json object holding them and their sequence number. Clients send the
+// sequence number of the last commands received as the ack query parameter of
+// their next poll, and commands not acknowledged are sent again, as described
+// by Session.Poll. The messages of the client are received through POST
+// requests to the Handler.
+type PollingTransport struct {
+	// Timeout defines how long a poll waits for commands before responding
+	// with no commands.
+	Timeout time.Duration
+}
+
+// pollResponse defines the json response of a poll.
+type pollResponse struct {
+	Sequence int               `json:"Sequence"`
+	Commands []json.RawMessage `json:"Commands"`
+}
+
+// Serve implements the Transport interface.
+func (t *PollingTransport) Serve(w http.ResponseWriter, r *http.Request, session *Session) {
+	ctx, cancel := context.WithTimeout(r.Context(), t.Timeout)
+	defer cancel()
+
+	ack, _ := strconv.Atoi(r.URL.Query().Get("ack"))
+
+	commands, sequence, ok := session.Poll(ack, ctx.Done())
+	if !ok {
+		http.Error(w, "Session closed", http.StatusGone)
+		return
+	}
+
+	response := pollResponse{
+		Sequence: sequence,
+		Commands: make([]json.RawMessage, 0, len(commands)),
+	}
+
+	for _, command := range commands {
+		response.Commands = append(response.Commands, command)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	// Commands of a response which fails to reach the client are not
+	// acknowledged by its next poll, which sends them again.
+	json.NewEncoder(w).Encode(response)
+}
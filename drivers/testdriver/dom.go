@@ -0,0 +1,354 @@
+package testdriver
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/trees"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// DOM defines a drivers.Display which keeps an in-memory html document built
+// from the commands applied to it, along with the events registered by the
+// markup of each view.
+type DOM struct {
+	ml       sync.Mutex
+	document *html.Node
+	events   map[string][]trees.EventJSON
+}
+
+// NewDOM returns a new DOM containing an empty document.
+func NewDOM() *DOM {
+	dom := &DOM{
+		events: make(map[string][]trees.EventJSON),
+	}
+
+	dom.document, _, _ = newDocument()
+
+	return dom
+}
+
+// Apply implements the drivers.Display interface, replacing the document for a
+// RenderApp command, replacing the node of the view for a RenderView command
+// and replacing the node of the component for a RenderComponent command.
+func (d *DOM) Apply(command gu.RenderCommand) error {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	switch command.Command {
+	case "RenderApp":
+		return d.renderApp(command.App)
+	case "RenderView":
+		return d.renderView(command.View)
+	case "RenderComponent":
+		return d.renderComponent(command.View)
+	}
+
+	return errors.New("Unknown rendering command")
+}
+
+// HTML returns the html of the document.
+func (d *DOM) HTML() string {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	return render(d.document)
+}
+
+// Find returns the first node of the document matching the selector.
+func (d *DOM) Find(selector string) (*Node, error) {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	node := find(d.document, selector)
+	if node == nil {
+		return nil, errors.New("No node matches selector")
+	}
+
+	return &Node{Node: node}, nil
+}
+
+// FindAll returns the nodes of the document matching the selector in document
+// order.
+func (d *DOM) FindAll(selector string) []*Node {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	var nodes []*Node
+
+	walk(d.document, func(node *html.Node) {
+		if matches(node, selector) {
+			nodes = append(nodes, &Node{Node: node})
+		}
+	})
+
+	return nodes
+}
+
+// Broadcasts returns the common.EventBroadcast for each handler of the event
+// type registered on the first node matching the selector or its ancestors,
+// in the order the event bubbles. Handlers which stop propagation end the
+// bubbling of the event. The event should be a eventx type e.g
+// eventx.MouseEvent.
+func (d *DOM) Broadcasts(selector string, eventType string, event interface{}) ([]common.EventBroadcast, error) {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	target := find(d.document, selector)
+	if target == nil {
+		return nil, errors.New("No node matches selector")
+	}
+
+	var broadcasts []common.EventBroadcast
+
+	for node := target; node != nil; node = node.Parent {
+		var stopped bool
+
+		for _, events := range d.events {
+			for _, meta := range events {
+				if !strings.EqualFold(meta.Event, eventType) || !matches(node, meta.EventSelector) {
+					continue
+				}
+
+				broadcasts = append(broadcasts, common.EventBroadcast{
+					EventName: meta.EventName,
+					EventID:   meta.EventID,
+					Event:     eventx.NewBaseEvent(event, nil),
+				})
+
+				stopped = stopped || meta.StopPropagation
+			}
+		}
+
+		if stopped {
+			break
+		}
+	}
+
+	return broadcasts, nil
+}
+
+// setAttr sets the attribute of the first node matching the selector.
+func (d *DOM) setAttr(selector string, name string, value string) error {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	node := find(d.document, selector)
+	if node == nil {
+		return errors.New("No node matches selector")
+	}
+
+	for index, attr := range node.Attr {
+		if attr.Key == name {
+			node.Attr[index].Val = value
+			return nil
+		}
+	}
+
+	node.Attr = append(node.Attr, html.Attribute{Key: name, Val: value})
+
+	return nil
+}
+
+// renderApp replaces the document with the views and resources of the app.
+func (d *DOM) renderApp(app gu.AppJSON) error {
+	document, head, body := newDocument()
+
+	root := head.Parent
+
+	if app.ThemeVariant != "" {
+		root.Attr = append(root.Attr, html.Attribute{Key: common.ThemeVariantAttr, Val: app.ThemeVariant})
+	}
+
+	if app.Locale != "" {
+		root.Attr = append(root.Attr, html.Attribute{Key: "lang", Val: app.Locale})
+		root.Attr = append(root.Attr, html.Attribute{Key: "dir", Val: app.Direction})
+	}
+
+	body.Attr = append(body.Attr, html.Attribute{Key: "gu-app-id", Val: app.AppID})
+
+	events := make(map[string][]trees.EventJSON)
+
+	for _, resource := range app.HeadResources {
+		if err := appendMarkup(head, resource.Markup); err != nil {
+			return err
+		}
+
+		events[resource.TreeID] = resource.Events
+	}
+
+	for _, view := range app.Head {
+		if err := appendMarkup(head, view.Tree.Markup); err != nil {
+			return err
+		}
+
+		events[view.ViewID] = view.Tree.Events
+	}
+
+	for _, view := range app.Body {
+		if err := appendMarkup(body, view.Tree.Markup); err != nil {
+			return err
+		}
+
+		events[view.ViewID] = view.Tree.Events
+	}
+
+	for _, resource := range app.BodyResources {
+		if err := appendMarkup(body, resource.Markup); err != nil {
+			return err
+		}
+
+		events[resource.TreeID] = resource.Events
+	}
+
+	d.document = document
+	d.events = events
+
+	return nil
+}
+
+// renderView replaces the node of the view with its new markup.
+func (d *DOM) renderView(view gu.ViewJSON) error {
+	return d.renderTree(view.ViewID, view.Tree)
+}
+
+// renderComponent replaces the node of the component within its view with its
+// new markup.
+func (d *DOM) renderComponent(view gu.ViewJSON) error {
+	return d.renderTree(view.Tree.TreeID, view.Tree)
+}
+
+// renderTree replaces the node with the uid with the markup of the tree, where
+// the events registered for the node and its children are replaced by the
+// events of the tree.
+func (d *DOM) renderTree(uid string, tree trees.MarkupJSON) error {
+	target := find(d.document, "[uid='"+uid+"']")
+	if target == nil {
+		return errors.New("Tree is not rendered in document")
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(tree.Markup), target.Parent)
+	if err != nil {
+		return err
+	}
+
+	d.removeEvents(target)
+
+	for _, node := range nodes {
+		target.Parent.InsertBefore(node, target)
+	}
+
+	target.Parent.RemoveChild(target)
+
+	d.events[uid] = tree.Events
+
+	return nil
+}
+
+// removeEvents removes the events registered for the node and its children.
+func (d *DOM) removeEvents(target *html.Node) {
+	for key, events := range d.events {
+		var kept []trees.EventJSON
+
+		for _, meta := range events {
+			var within bool
+
+			walk(target, func(node *html.Node) {
+				within = within || matches(node, meta.EventSelector)
+			})
+
+			if !within {
+				kept = append(kept, meta)
+			}
+		}
+
+		d.events[key] = kept
+	}
+}
+
+// Node defines a node of the DOM. Nodes are not updated once the app
+// re-renders, so nodes should be found again after an event or update.
+type Node struct {
+	*html.Node
+}
+
+// Attr returns the value of the attribute of the node, returning false if the
+// node has no such attribute.
+func (n *Node) Attr(name string) (string, bool) {
+	return lookupAttr(n.Node, name)
+}
+
+// UID returns the uid of the node.
+func (n *Node) UID() string {
+	uid, _ := n.Attr("uid")
+	return uid
+}
+
+// Text returns the text content of the node and its children.
+func (n *Node) Text() string {
+	var content bytes.Buffer
+
+	walk(n.Node, func(node *html.Node) {
+		if node.Type == html.TextNode {
+			content.WriteString(node.Data)
+		}
+	})
+
+	return content.String()
+}
+
+// HTML returns the html of the node.
+func (n *Node) HTML() string {
+	return render(n.Node)
+}
+
+// newDocument returns a new empty document along with its head and body.
+func newDocument() (*html.Node, *html.Node, *html.Node) {
+	document := &html.Node{Type: html.DocumentNode}
+	root := &html.Node{Type: html.ElementNode, Data: "html", DataAtom: atom.Html}
+	head := &html.Node{Type: html.ElementNode, Data: "head", DataAtom: atom.Head}
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+
+	document.AppendChild(root)
+	root.AppendChild(head)
+	root.AppendChild(body)
+
+	return document, head, body
+}
+
+// appendMarkup parses the markup within the context of the parent, appending
+// the nodes into the parent.
+func appendMarkup(parent *html.Node, markup string) error {
+	nodes, err := html.ParseFragment(strings.NewReader(markup), parent)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		parent.AppendChild(node)
+	}
+
+	return nil
+}
+
+// render returns the html of the node.
+func render(node *html.Node) string {
+	var content bytes.Buffer
+	html.Render(&content, node)
+	return content.String()
+}
+
+// walk calls the function for the node and its descendants in document order.
+func walk(node *html.Node, fn func(*html.Node)) {
+	fn(node)
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		walk(child, fn)
+	}
+}
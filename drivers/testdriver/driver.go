@@ -0,0 +1,235 @@
+// Package testdriver provides a drivers.Driver which renders apps into an
+// in-memory DOM, letting tests find the nodes of an app by selector, fire
+// events on them and assert on the re-rendered DOM without a browser.
+//
+//	page, err := testdriver.Open(app, "/home")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	defer page.Close()
+//
+//	page.Click("button")
+//
+//	node, err := page.Find("button")
+//	// node.Text() == "Clicks: 1"
+//
+// The driver renders synchronously within the goroutine which updates the app,
+// so the DOM reflects an event or navigation once the call causing it returns.
+// The scheduler of a mounted app only flushes after each event, so updates
+// published outside of events are rendered once the page is flushed.
+package testdriver
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/router"
+)
+
+// Driver defines a drivers.Driver which renders an app into its display
+// synchronously as the app updates.
+type Driver struct {
+	ml       sync.Mutex
+	app      *gu.NApp
+	display  drivers.Display
+	current  router.PushEvent
+	frames   gu.FrameSource
+	removers []common.Remover
+	mounted  bool
+}
+
+// New returns a new Driver at the root location.
+func New() *Driver {
+	current, _ := router.NewPushEvent("/", false)
+
+	return &Driver{
+		current: current,
+	}
+}
+
+// Location implements the gu.Location interface, returning the current
+// location of the driver.
+func (d *Driver) Location() router.PushEvent {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	return d.current
+}
+
+// Navigate implements the gu.Location interface, rendering the mounted app for
+// the provided path.
+func (d *Driver) Navigate(pd router.PushDirectiveEvent) {
+	pe, err := router.NewPushEvent(pd.To, false)
+	if err != nil {
+		return
+	}
+
+	d.ml.Lock()
+	d.current = pe
+	d.ml.Unlock()
+
+	d.renderApp(pe)
+}
+
+// Mount implements the drivers.Driver interface, rendering the app into the
+// display for the current location.
+func (d *Driver) Mount(app *gu.NApp, display drivers.Display) error {
+	d.ml.Lock()
+
+	if d.app != nil {
+		d.ml.Unlock()
+		return errors.New("Driver already has a mounted app")
+	}
+
+	d.app = app
+	d.display = display
+	d.mounted = true
+
+	d.removers = append(d.removers, notifications.SubscribeWithRemover(gu.NewAppUpdateHandler(func(update gu.AppUpdate) {
+		if update.App == app {
+			d.renderApp(nil)
+		}
+	})))
+
+	d.removers = append(d.removers, notifications.SubscribeWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		if update.App == app {
+			d.renderView(update.View)
+		}
+	})))
+
+	d.removers = append(d.removers, notifications.SubscribeWithRemover(gu.NewComponentUpdateHandler(func(update gu.ComponentUpdate) {
+		if update.App == app {
+			d.renderComponent(update.View, update.Component)
+		}
+	})))
+
+	// Flush the updates of the app only when the driver asks, so events
+	// render synchronously, restoring the previous frames once unmounted.
+	d.frames = app.Scheduler().Frames()
+	app.Scheduler().UseFrames(gu.ManualFrames)
+
+	current := d.current
+	d.ml.Unlock()
+
+	app.InitApp(d)
+
+	d.renderApp(current)
+
+	return nil
+}
+
+// Dispatch implements the drivers.Driver interface, delivering the event to the
+// event handlers of the mounted app and rendering the updates they caused.
+func (d *Driver) Dispatch(event common.EventBroadcast) {
+	app, _, ok := d.active()
+	if !ok {
+		return
+	}
+
+	notifications.Dispatch(event)
+	app.Scheduler().Flush()
+}
+
+// Flush renders the pending updates of the views and components of the
+// mounted app.
+func (d *Driver) Flush() {
+	if app, _, ok := d.active(); ok {
+		app.Scheduler().Flush()
+	}
+}
+
+// Unmount implements the drivers.Driver interface, notifying the active views
+// of the app that they have been unmounted and restoring the FrameSource of
+// its scheduler, after which another app can be mounted.
+func (d *Driver) Unmount() {
+	d.ml.Lock()
+
+	if !d.mounted {
+		d.ml.Unlock()
+		return
+	}
+
+	app, frames, removers := d.app, d.frames, d.removers
+
+	d.app = nil
+	d.display = nil
+	d.frames = nil
+	d.removers = nil
+	d.mounted = false
+	d.ml.Unlock()
+
+	for _, remover := range removers {
+		remover.Remove()
+	}
+
+	app.Scheduler().UseFrames(frames)
+
+	for _, view := range app.ActiveViews() {
+		view.Unmounted()
+	}
+}
+
+// active returns the mounted app and its display, returning false if no app is
+// mounted.
+func (d *Driver) active() (*gu.NApp, drivers.Display, bool) {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	return d.app, d.display, d.mounted
+}
+
+// renderApp renders the app into the display, notifying the views which become
+// active that they have been mounted.
+func (d *Driver) renderApp(route interface{}) {
+	app, display, ok := d.active()
+	if !ok {
+		return
+	}
+
+	previous := app.ActiveViews()
+
+	display.Apply(gu.AppRenderCommand(app, route))
+
+	for _, view := range app.ActiveViews() {
+		if !contains(previous, view) {
+			view.Mounted()
+		}
+	}
+}
+
+// renderView renders the view into the display if the view is active.
+func (d *Driver) renderView(view *gu.NView) {
+	app, display, ok := d.active()
+	if !ok || !contains(app.ActiveViews(), view) {
+		return
+	}
+
+	display.Apply(gu.ViewRenderCommand(view))
+}
+
+// renderComponent renders the component into the display if its view is
+// active.
+func (d *Driver) renderComponent(view *gu.NView, component *gu.Component) {
+	app, display, ok := d.active()
+	if !ok || !contains(app.ActiveViews(), view) {
+		return
+	}
+
+	display.Apply(gu.ComponentRenderCommand(component))
+}
+
+// contains returns true/false if the view is within the views.
+func contains(views []*gu.NView, view *gu.NView) bool {
+	for _, item := range views {
+		if item == view {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+package testdriver
+
+import (
+	"sync"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/router"
+)
+
+// Page defines an app mounted by a Driver into a DOM, providing methods to
+// find the nodes of the app and fire events on them.
+type Page struct {
+	Driver *Driver
+	DOM    *DOM
+}
+
+// Open returns a new Page with the app mounted at the provided location.
+func Open(app *gu.NApp, location string) (*Page, error) {
+	driver := New()
+	driver.Navigate(router.PushDirectiveEvent{To: location})
+
+	dom := NewDOM()
+
+	if err := driver.Mount(app, dom); err != nil {
+		return nil, err
+	}
+
+	return &Page{Driver: driver, DOM: dom}, nil
+}
+
+// Close unmounts the app of the page.
+func (p *Page) Close() {
+	p.Driver.Unmount()
+}
+
+// Navigate renders the app of the page for the provided path.
+func (p *Page) Navigate(path string) {
+	p.Driver.Navigate(router.PushDirectiveEvent{To: path})
+}
+
+// Flush renders the pending updates of the app published outside of events.
+func (p *Page) Flush() {
+	p.Driver.Flush()
+}
+
+// Find returns the first node of the page matching the selector.
+func (p *Page) Find(selector string) (*Node, error) {
+	return p.DOM.Find(selector)
+}
+
+// FindAll returns the nodes of the page matching the selector.
+func (p *Page) FindAll(selector string) []*Node {
+	return p.DOM.FindAll(selector)
+}
+
+// HTML returns the html of the page.
+func (p *Page) HTML() string {
+	return p.DOM.HTML()
+}
+
+// Fire fires the event of the provided type on the first node matching the
+// selector, delivering it through the driver to the handlers registered on
+// the node and its ancestors. The event should be a eventx type e.g
+// eventx.MouseEvent.
+func (p *Page) Fire(selector string, eventType string, event interface{}) error {
+	broadcasts, err := p.DOM.Broadcasts(selector, eventType, event)
+	if err != nil {
+		return err
+	}
+
+	for _, broadcast := range broadcasts {
+		p.Driver.Dispatch(broadcast)
+	}
+
+	return nil
+}
+
+// Click fires a click event on the first node matching the selector.
+func (p *Page) Click(selector string) error {
+	return p.Fire(selector, "click", &eventx.MouseEvent{})
+}
+
+// Input sets the value of the first node matching the selector, firing an input
+// event with the value as its data.
+func (p *Page) Input(selector string, value string) error {
+	if err := p.DOM.setAttr(selector, "value", value); err != nil {
+		return err
+	}
+
+	return p.Fire(selector, "input", &eventx.InputEvent{Data: value})
+}
+
+// KeyDown fires a keydown event for the key on the first node matching the
+// selector.
+func (p *Page) KeyDown(selector string, key string) error {
+	return p.Fire(selector, "keydown", &eventx.KeyboardEvent{Key: key})
+}
+
+// Lifecycle defines a record of the lifecycle notifications received by a
+// view.
+type Lifecycle struct {
+	ml        sync.Mutex
+	mounted   int
+	unmounted int
+}
+
+// Track returns a Lifecycle recording the notifications of the view from the
+// call onward.
+func Track(view *gu.NView) *Lifecycle {
+	var lifecycle Lifecycle
+
+	services := view.Services()
+
+	services.Mounted.React(func() {
+		lifecycle.ml.Lock()
+		lifecycle.mounted++
+		lifecycle.ml.Unlock()
+	})
+
+	services.Unmounted.React(func() {
+		lifecycle.ml.Lock()
+		lifecycle.unmounted++
+		lifecycle.ml.Unlock()
+	})
+
+	return &lifecycle
+}
+
+// Mounted returns the number of times the view was notified it is mounted.
+func (l *Lifecycle) Mounted() int {
+	l.ml.Lock()
+	defer l.ml.Unlock()
+
+	return l.mounted
+}
+
+// Unmounted returns the number of times the view was notified it is unmounted.
+func (l *Lifecycle) Unmounted() int {
+	l.ml.Lock()
+	defer l.ml.Unlock()
+
+	return l.unmounted
+}
@@ -0,0 +1,154 @@
+package testdriver
+
+import (
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+	"golang.org/x/net/html"
+)
+
+// find returns the first node within the root matching the selector in document
+// order.
+func find(root *html.Node, selector string) *html.Node {
+	var found *html.Node
+
+	walk(root, func(node *html.Node) {
+		if found == nil && matches(node, selector) {
+			found = node
+		}
+	})
+
+	return found
+}
+
+// matches returns true/false if the node matches the selector, which is parsed
+// with trees.Query. Selectors of tags, ids, classes and attributes are
+// supported along with descendant selectors and groups of selectors separated
+// by commas.
+func matches(node *html.Node, selector string) bool {
+	if node.Type != html.ElementNode {
+		return false
+	}
+
+	for _, sel := range trees.Query.ParseSelector(selector) {
+		if matchesChain(node, sel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesChain returns true/false if the node matches the last selector of the
+// descendant chain of the selector and its ancestors match the rest of the
+// chain in order.
+func matchesChain(node *html.Node, sel *trees.Selector) bool {
+	chain := []*trees.Selector{sel}
+
+	for _, child := range sel.Children {
+		// child combinators are matched as descendants.
+		if child.Tag == ">" {
+			continue
+		}
+
+		chain = append(chain, child)
+	}
+
+	last := len(chain) - 1
+	if !matchesOne(node, chain[last]) {
+		return false
+	}
+
+	for index, ancestor := last-1, node.Parent; index >= 0; ancestor = ancestor.Parent {
+		if ancestor == nil {
+			return false
+		}
+
+		if matchesOne(ancestor, chain[index]) {
+			index--
+		}
+	}
+
+	return true
+}
+
+// matchesOne returns true/false if the node matches the selector without its
+// children.
+func matchesOne(node *html.Node, sel *trees.Selector) bool {
+	if node.Type != html.ElementNode {
+		return false
+	}
+
+	if sel.Tag != "" && sel.Tag != "*" && !strings.EqualFold(node.Data, sel.Tag) {
+		return false
+	}
+
+	if sel.ID != "" && attr(node, "id") != sel.ID {
+		return false
+	}
+
+	if len(sel.Classes) != 0 {
+		classes := strings.Fields(attr(node, "class"))
+
+		for _, class := range sel.Classes {
+			if !containsString(classes, class) {
+				return false
+			}
+		}
+	}
+
+	if sel.AttrName == "" {
+		return true
+	}
+
+	value, ok := lookupAttr(node, sel.AttrName)
+	if !ok {
+		return false
+	}
+
+	switch sel.AttrOp {
+	case "=":
+		return value == sel.AttrValue
+	case "~=":
+		return containsString(strings.Fields(value), sel.AttrValue)
+	case "|=":
+		return value == sel.AttrValue || strings.HasPrefix(value, sel.AttrValue+"-")
+	case "^=":
+		return strings.HasPrefix(value, sel.AttrValue)
+	case "$=":
+		return strings.HasSuffix(value, sel.AttrValue)
+	case "*=":
+		return strings.Contains(value, sel.AttrValue)
+	}
+
+	return true
+}
+
+// attr returns the value of the attribute of the node.
+func attr(node *html.Node, name string) string {
+	value, _ := lookupAttr(node, name)
+	return value
+}
+
+// lookupAttr returns the value of the attribute of the node, returning false if
+// the node has no such attribute.
+func lookupAttr(node *html.Node, name string) (string, bool) {
+	for _, attr := range node.Attr {
+		if attr.Key == name {
+			return attr.Val, true
+		}
+	}
+
+	return "", false
+}
+
+// containsString returns true/false if the value is within the values.
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,768 @@
+package testdriver_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers"
+	"github.com/gu-io/gu/drivers/drivertest"
+	"github.com/gu-io/gu/drivers/testdriver"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/gu-io/gu/trees/property"
+	"github.com/influx6/faux/tests"
+)
+
+type form struct {
+	gu.Reactive
+	name      string
+	submitted string
+}
+
+func (f *form) Render() *trees.Markup {
+	return elems.Div(
+		property.ClassAttr("form"),
+		elems.Input(
+			property.TypeAttr("text"),
+			events.InputEvent(func(ev common.EventObject) {
+				f.name = ev.Underlying().(*eventx.InputEvent).Data
+				f.Publish()
+			}),
+			events.KeyDownEvent(func(ev common.EventObject) {
+				if ev.Underlying().(*eventx.KeyboardEvent).Key == "Enter" {
+					f.submitted = f.name
+					f.Publish()
+				}
+			}),
+		),
+		elems.Paragraph(elems.Text("Name: %s", f.name)),
+		elems.Span(property.ClassAttr("submitted"), elems.Text("%s", f.submitted)),
+	)
+}
+
+type counter struct {
+	gu.Reactive
+	clicks int
+}
+
+func (c *counter) Render() *trees.Markup {
+	return elems.Button(
+		elems.Text("Clicks: %d", c.clicks),
+		events.ClickEvent(func() {
+			c.clicks++
+			c.Publish()
+		}),
+	)
+}
+
+type panel struct {
+	services  gu.Services
+	mounted   int
+	unmounted int
+}
+
+func (p *panel) Render() *trees.Markup {
+	return elems.Div(property.ClassAttr("panel"))
+}
+
+func (p *panel) UseServices(services gu.Services) {
+	p.services = services
+}
+
+func (p *panel) Mounted() {
+	p.mounted++
+}
+
+func (p *panel) Unmounted() {
+	p.unmounted++
+}
+
+type tally struct {
+	gu.Reactive
+	name    string
+	clicks  int
+	renders int
+}
+
+func (t *tally) Render() *trees.Markup {
+	t.renders++
+
+	return elems.Button(
+		property.ClassAttr(t.name),
+		elems.Text("%s: %d", t.name, t.clicks),
+		events.ClickEvent(func() {
+			t.clicks++
+			t.Publish()
+		}),
+	)
+}
+
+type burst struct {
+	gu.Reactive
+	total   int
+	renders int
+}
+
+func (b *burst) Render() *trees.Markup {
+	b.renders++
+
+	return elems.Button(
+		elems.Text("Total: %d", b.total),
+		events.ClickEvent(func() {
+			for index := 0; index < 10; index++ {
+				b.total++
+				b.Publish()
+			}
+		}),
+	)
+}
+
+type board struct {
+	tallies []*tally
+	renders int
+}
+
+func (b *board) Render() *trees.Markup {
+	b.renders++
+	return elems.Div(property.ClassAttr("board"), elems.Section())
+}
+
+func (b *board) Compose(component *gu.Component) {
+	for _, item := range b.tallies {
+		component.Component(item, gu.AnyOrder, "*", "section")
+	}
+}
+
+type faulty struct{}
+
+func (faulty) Render() *trees.Markup {
+	panic("faulty component")
+}
+
+type sheet struct{}
+
+func (sheet) Render() *trees.Markup {
+	return elems.Section(
+		elems.CSS("section { color: {{.Missing}}; }", struct{}{}, nil),
+		elems.Text("Styled"),
+	)
+}
+
+func TestConformance(t *testing.T) {
+	drivertest.Run(t, func() drivers.Driver {
+		return testdriver.New()
+	})
+}
+
+func TestPage(t *testing.T) {
+	app := gu.SeededApp("testdriver", "Test Driver", nil)
+
+	home := app.View(elems.Div(elems.Header1(elems.Text("Home"))), "/home", gu.BodyTarget)
+	home.Component(&counter{Reactive: gu.NewReactive()}, gu.AnyOrder, "*", "")
+
+	profile := app.View(elems.Div(elems.Header1(elems.Text("Profile"))), "/profile", gu.BodyTarget)
+	profile.Component(&form{Reactive: gu.NewReactive()}, gu.AnyOrder, "*", "")
+
+	homeLifecycle := testdriver.Track(home)
+	profileLifecycle := testdriver.Track(profile)
+
+	page, err := testdriver.Open(app, "/home")
+	if err != nil {
+		tests.Failed("Should have opened page for app: %+q", err)
+	}
+	tests.Passed("Should have opened page for app")
+
+	if homeLifecycle.Mounted() != 1 || profileLifecycle.Mounted() != 0 {
+		tests.Failed("Should have mounted only the active view")
+	}
+	tests.Passed("Should have mounted only the active view")
+
+	if node, err := page.Find("body h1"); err != nil || node.Text() != "Home" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have found header of home view")
+	}
+	tests.Passed("Should have found header of home view")
+
+	if err := page.Click("button"); err != nil {
+		tests.Failed("Should have clicked button: %+q", err)
+	}
+
+	if err := page.Click("button"); err != nil {
+		tests.Failed("Should have clicked button: %+q", err)
+	}
+
+	if node, err := page.Find("button"); err != nil || node.Text() != "Clicks: 2" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have re-rendered button after clicks")
+	}
+	tests.Passed("Should have re-rendered button after clicks")
+
+	page.Navigate("/profile")
+
+	if len(page.FindAll("button")) != 0 {
+		tests.Failed("Should have removed home view after navigation")
+	}
+	tests.Passed("Should have removed home view after navigation")
+
+	if profileLifecycle.Mounted() != 1 || homeLifecycle.Unmounted() == 0 {
+		tests.Failed("Should have mounted profile view and unmounted home view")
+	}
+	tests.Passed("Should have mounted profile view and unmounted home view")
+
+	if err := page.Input(".form input[type=text]", "Alex"); err != nil {
+		tests.Failed("Should have fired input event: %+q", err)
+	}
+
+	if node, err := page.Find("p"); err != nil || node.Text() != "Name: Alex" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have re-rendered form after input event")
+	}
+	tests.Passed("Should have re-rendered form after input event")
+
+	if err := page.KeyDown("input", "Enter"); err != nil {
+		tests.Failed("Should have fired keydown event: %+q", err)
+	}
+
+	if node, err := page.Find("span.submitted"); err != nil || node.Text() != "Alex" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have re-rendered form after keydown event")
+	}
+	tests.Passed("Should have re-rendered form after keydown event")
+
+	if err := page.Click("#missing"); err == nil {
+		tests.Failed("Should have failed to fire event on missing node")
+	}
+	tests.Passed("Should have failed to fire event on missing node")
+
+	unmounted := profileLifecycle.Unmounted()
+
+	page.Close()
+
+	if profileLifecycle.Unmounted() != unmounted+1 {
+		tests.Failed("Should have unmounted active view once closed")
+	}
+	tests.Passed("Should have unmounted active view once closed")
+}
+
+// requestedFrames defines a gu.FrameSource which counts the frames requested.
+type requestedFrames struct {
+	requests int
+}
+
+// Request implements the gu.FrameSource interface.
+func (r *requestedFrames) Request(func()) {
+	r.requests++
+}
+
+func TestUnmount(t *testing.T) {
+	app := gu.SeededApp("unmount", "Unmount", nil)
+	app.View(elems.Div(elems.Text("Home")), "/home", gu.BodyTarget)
+
+	frames := new(requestedFrames)
+	app.Scheduler().UseFrames(frames)
+
+	driver := testdriver.New()
+	driver.Navigate(router.PushDirectiveEvent{To: "/home"})
+
+	if err := driver.Mount(app, testdriver.NewDOM()); err != nil {
+		tests.Failed("Should have mounted app: %+q", err)
+	}
+	tests.Passed("Should have mounted app")
+
+	if app.Scheduler().Frames() == frames {
+		tests.Failed("Should have flushed mounted app manually")
+	}
+	tests.Passed("Should have flushed mounted app manually")
+
+	driver.Unmount()
+
+	if app.Scheduler().Frames() != frames {
+		tests.Failed("Should have restored frames of app once unmounted")
+	}
+	tests.Passed("Should have restored frames of app once unmounted")
+
+	other := gu.SeededApp("remount", "Remount", nil)
+	other.View(elems.Div(elems.Text("Other")), "/home", gu.BodyTarget)
+
+	dom := testdriver.NewDOM()
+
+	if err := driver.Mount(other, dom); err != nil {
+		tests.Failed("Should have mounted another app once unmounted: %+q", err)
+	}
+	tests.Passed("Should have mounted another app once unmounted")
+
+	if node, err := dom.Find("div"); err != nil || node.Text() != "Other" {
+		t.Logf("\t\tRecieved: %s\n", dom.HTML())
+		tests.Failed("Should have rendered the app mounted after unmount")
+	}
+	tests.Passed("Should have rendered the app mounted after unmount")
+
+	driver.Unmount()
+}
+
+func TestComponentLifecycle(t *testing.T) {
+	app := gu.SeededApp("lifecycle", "Lifecycle", nil)
+
+	var profile, security panel
+
+	settings := app.View(elems.Div(elems.Header1(elems.Text("Settings"))), "/settings/*", gu.BodyTarget)
+	settings.Component(&profile, gu.AnyOrder, "/profile", "")
+	settings.Component(&security, gu.AnyOrder, "/security", "")
+	app.View(elems.Div(elems.Header1(elems.Text("Home"))), "/home", gu.BodyTarget)
+
+	if profile.services.ViewRoute == nil || profile.services.ViewRoute.Pattern() != "/profile" {
+		tests.Failed("Should have received services with route of component")
+	}
+	tests.Passed("Should have received services with route of component")
+
+	page, err := testdriver.Open(app, "/settings/profile")
+	if err != nil {
+		tests.Failed("Should have opened page for app: %+q", err)
+	}
+	tests.Passed("Should have opened page for app")
+
+	if profile.mounted != 1 || security.mounted != 0 {
+		t.Logf("\t\tRecieved: %d %d\n", profile.mounted, security.mounted)
+		tests.Failed("Should have mounted only the component whose route matched")
+	}
+	tests.Passed("Should have mounted only the component whose route matched")
+
+	page.Navigate("/settings/security")
+
+	if profile.unmounted != 1 || security.mounted != 1 {
+		t.Logf("\t\tRecieved: %d %d\n", profile.unmounted, security.mounted)
+		tests.Failed("Should have unmounted component whose route failed while its view remained")
+	}
+	tests.Passed("Should have unmounted component whose route failed while its view remained")
+
+	page.Navigate("/home")
+	page.Navigate("/about")
+
+	if profile.unmounted != 1 || security.unmounted != 1 {
+		t.Logf("\t\tRecieved: %d %d\n", profile.unmounted, security.unmounted)
+		tests.Failed("Should have unmounted components once with their view")
+	}
+	tests.Passed("Should have unmounted components once with their view")
+
+	page.Close()
+}
+
+func TestNestedComponents(t *testing.T) {
+	app := gu.SeededApp("nested", "Nested", nil)
+
+	apples := &tally{Reactive: gu.NewReactive(), name: "apples"}
+	pears := &tally{Reactive: gu.NewReactive(), name: "pears"}
+	root := &board{tallies: []*tally{apples, pears}}
+
+	view := app.View(elems.Div(elems.Header1(elems.Text("Board"))), "/board", gu.BodyTarget)
+	view.Component(root, gu.AnyOrder, "*", "")
+
+	page, err := testdriver.Open(app, "/board")
+	if err != nil {
+		tests.Failed("Should have opened page for app: %+q", err)
+	}
+	tests.Passed("Should have opened page for app")
+
+	defer page.Close()
+
+	if len(page.FindAll(".board section button")) != 2 {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have rendered sub-components into their target")
+	}
+	tests.Passed("Should have rendered sub-components into their target")
+
+	boardRenders, pearRenders := root.renders, pears.renders
+
+	for index := 0; index < 2; index++ {
+		if err := page.Click("button.apples"); err != nil {
+			tests.Failed("Should have clicked button: %+q", err)
+		}
+	}
+
+	if node, err := page.Find("button.apples"); err != nil || node.Text() != "apples: 2" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have re-rendered sub-component after clicks")
+	}
+	tests.Passed("Should have re-rendered sub-component after clicks")
+
+	if root.renders != boardRenders || pears.renders != pearRenders {
+		t.Logf("\t\tRecieved: %d %d\n", root.renders-boardRenders, pears.renders-pearRenders)
+		tests.Failed("Should have re-rendered only the sub-component which changed")
+	}
+	tests.Passed("Should have re-rendered only the sub-component which changed")
+
+	if len(page.FindAll("button")) != 2 || len(page.FindAll("h1")) != 1 {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have patched sub-component in place")
+	}
+	tests.Passed("Should have patched sub-component in place")
+
+	view.Publish()
+	page.Flush()
+
+	if err := page.Click("button.apples"); err != nil {
+		tests.Failed("Should have clicked button: %+q", err)
+	}
+
+	if node, err := page.Find("button.apples"); err != nil || node.Text() != "apples: 3" || len(page.FindAll("button")) != 2 {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have handled click once after view re-rendered")
+	}
+	tests.Passed("Should have handled click once after view re-rendered")
+}
+
+func TestScheduler(t *testing.T) {
+	app := gu.SeededApp("scheduler", "Scheduler", nil)
+
+	counter := &burst{Reactive: gu.NewReactive()}
+
+	view := app.View(elems.Div(elems.Header1(elems.Text("Burst"))), "/burst", gu.BodyTarget)
+	view.Component(counter, gu.AnyOrder, "*", "")
+
+	page, err := testdriver.Open(app, "/burst")
+	if err != nil {
+		tests.Failed("Should have opened page for app: %+q", err)
+	}
+	tests.Passed("Should have opened page for app")
+
+	renders := counter.renders
+
+	if err := page.Click("button"); err != nil {
+		tests.Failed("Should have clicked button: %+q", err)
+	}
+
+	if node, err := page.Find("button"); err != nil || node.Text() != "Total: 10" || counter.renders != renders+1 {
+		t.Logf("\t\tRecieved: %d renders of %s\n", counter.renders-renders, page.HTML())
+		tests.Failed("Should have rendered component once for many publishes")
+	}
+	tests.Passed("Should have rendered component once for many publishes")
+
+	page.Close()
+
+	var frames []func()
+	app.Scheduler().UseFrames(gu.FrameFunc(func(fn func()) {
+		frames = append(frames, fn)
+	}))
+
+	var viewUpdates, componentUpdates int
+
+	viewRemover := notifications.SubscribeWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		if update.App == app {
+			viewUpdates++
+		}
+	}))
+	defer viewRemover.Remove()
+
+	componentRemover := notifications.SubscribeWithRemover(gu.NewComponentUpdateHandler(func(update gu.ComponentUpdate) {
+		if update.App == app {
+			componentUpdates++
+		}
+	}))
+	defer componentRemover.Remove()
+
+	counter.Publish()
+	counter.Publish()
+
+	if len(frames) != 1 || componentUpdates != 0 {
+		t.Logf("\t\tRecieved: %d frames and %d updates\n", len(frames), componentUpdates)
+		tests.Failed("Should have requested a single frame without updating")
+	}
+	tests.Passed("Should have requested a single frame without updating")
+
+	frames[0]()
+
+	if componentUpdates != 1 {
+		t.Logf("\t\tRecieved: %d\n", componentUpdates)
+		tests.Failed("Should have updated component once on frame")
+	}
+	tests.Passed("Should have updated component once on frame")
+
+	counter.Publish()
+	view.Publish()
+	app.Scheduler().Flush()
+
+	if viewUpdates != 1 || componentUpdates != 1 {
+		t.Logf("\t\tRecieved: %d %d\n", viewUpdates, componentUpdates)
+		tests.Failed("Should have dropped update of component whose view updated")
+	}
+	tests.Passed("Should have dropped update of component whose view updated")
+}
+
+func TestErrorBoundary(t *testing.T) {
+	app := gu.SeededApp("boundary", "Boundary", nil)
+
+	view := app.View(elems.Div(elems.Header1(elems.Text("Boundary"))), "/boundary", gu.BodyTarget)
+
+	fallback := view.Component(faulty{}, gu.AnyOrder, "*", "")
+	fallback.UseFallback(elems.Paragraph(elems.Text("Unavailable")))
+
+	bare := view.Component(faulty{}, gu.AnyOrder, "*", "")
+	view.Component(&counter{Reactive: gu.NewReactive()}, gu.AnyOrder, "*", "")
+	view.Component(struct{}{}, gu.AnyOrder, "*", "")
+	view.Component(sheet{}, gu.AnyOrder, "*", "")
+
+	var failures []gu.RenderError
+
+	remover := notifications.SubscribeWithRemover(gu.NewRenderErrorHandler(func(failure gu.RenderError) {
+		if failure.App == app {
+			failures = append(failures, failure)
+		}
+	}))
+	defer remover.Remove()
+
+	page, err := testdriver.Open(app, "/boundary")
+	if err != nil {
+		tests.Failed("Should have opened page for app: %+q", err)
+	}
+	tests.Passed("Should have opened page for app")
+
+	defer page.Close()
+
+	if node, err := page.Find("p"); err != nil || node.Text() != "Unavailable" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have rendered fallback of failed component")
+	}
+	tests.Passed("Should have rendered fallback of failed component")
+
+	if len(page.FindAll("h1")) != 1 || len(page.FindAll("button")) != 1 {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have rendered the rest of the view")
+	}
+	tests.Passed("Should have rendered the rest of the view")
+
+	if len(page.FindAll("[gu-render-error]")) != 2 {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have marked failed components without fallback")
+	}
+	tests.Passed("Should have marked failed components without fallback")
+
+	if node, err := page.Find("style"); err != nil || node.Text() != "" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have left stylesheet with failed template empty")
+	}
+	tests.Passed("Should have left stylesheet with failed template empty")
+
+	if len(failures) < 4 {
+		t.Logf("\t\tRecieved: %d\n", len(failures))
+		tests.Failed("Should have reported each failure through RenderError")
+	}
+	tests.Passed("Should have reported each failure through RenderError")
+
+	var reported bool
+	for _, failure := range failures {
+		if failure.Component == bare && failure.UUID == bare.UUID() && len(failure.Stack) != 0 && failure.Err != nil {
+			reported = true
+		}
+	}
+
+	if !reported {
+		tests.Failed("Should have reported component and stack trace of panic")
+	}
+	tests.Passed("Should have reported component and stack trace of panic")
+}
+
+func TestAsync(t *testing.T) {
+	app := gu.SeededApp("async", "Async", nil)
+
+	release := make(chan struct{})
+	cancelled := make(chan struct{}, 1)
+
+	profile := gu.Async(func(ctx context.Context) (interface{}, error) {
+		select {
+		case <-release:
+			return elems.Paragraph(elems.Text("Loaded Profile")), nil
+		case <-ctx.Done():
+			cancelled <- struct{}{}
+			return nil, ctx.Err()
+		}
+	}, elems.Paragraph(elems.Text("Loading")), nil)
+
+	failing := gu.Async(func(ctx context.Context) (interface{}, error) {
+		return nil, errors.New("No profile")
+	}, nil, func(err error) interface{} {
+		return elems.Span(elems.Text("%s", err.Error()))
+	})
+
+	view := app.View(elems.Div(elems.Header1(elems.Text("Account"))), "/account/*", gu.BodyTarget)
+	view.Component(profile, gu.AnyOrder, "/profile", "")
+	view.Component(failing, gu.AnyOrder, "*", "")
+
+	page, err := testdriver.Open(app, "/account/profile")
+	if err != nil {
+		tests.Failed("Should have opened page for app: %+q", err)
+	}
+	tests.Passed("Should have opened page for app")
+
+	defer page.Close()
+
+	if node, err := page.Find("p"); err != nil || node.Text() != "Loading" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have rendered placeholder while loading")
+	}
+	tests.Passed("Should have rendered placeholder while loading")
+
+	close(release)
+
+	if err := profile.Load(context.Background()); err != nil {
+		tests.Failed("Should have waited for loader: %+q", err)
+	}
+
+	if err := failing.Load(context.Background()); err != nil {
+		tests.Failed("Should have waited for loader: %+q", err)
+	}
+
+	page.Flush()
+
+	if node, err := page.Find("p"); err != nil || node.Text() != "Loaded Profile" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have re-rendered component with loaded content")
+	}
+	tests.Passed("Should have re-rendered component with loaded content")
+
+	if node, err := page.Find("span"); err != nil || node.Text() != "No profile" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have rendered failure of loader")
+	}
+	tests.Passed("Should have rendered failure of loader")
+
+	pending := gu.Async(func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		cancelled <- struct{}{}
+		return nil, ctx.Err()
+	}, nil, nil)
+
+	view.Component(pending, gu.AnyOrder, "/settings", "")
+
+	page.Navigate("/account/settings")
+	page.Navigate("/account/profile")
+
+	<-cancelled
+
+	if node, err := page.Find("p"); err != nil || node.Text() != "Loaded Profile" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have cancelled loader once route changed while keeping loaded content")
+	}
+	tests.Passed("Should have cancelled loader once route changed while keeping loaded content")
+}
+
+func TestAsyncView(t *testing.T) {
+	app := gu.SeededApp("async-view", "Async View", nil)
+
+	release := make(chan struct{})
+	cancelled := make(chan struct{}, 1)
+
+	feed := gu.Async(func(ctx context.Context) (interface{}, error) {
+		select {
+		case <-release:
+			return elems.Paragraph(elems.Text("Loaded Feed")), nil
+		case <-ctx.Done():
+			cancelled <- struct{}{}
+			return nil, ctx.Err()
+		}
+	}, elems.Paragraph(elems.Text("Loading")), nil)
+
+	app.View(feed, "/feed", gu.BodyTarget)
+	app.View(elems.Div(elems.Header1(elems.Text("Home"))), "/home", gu.BodyTarget)
+
+	page, err := testdriver.Open(app, "/feed")
+	if err != nil {
+		tests.Failed("Should have opened page for app: %+q", err)
+	}
+	tests.Passed("Should have opened page for app")
+
+	defer page.Close()
+
+	if node, err := page.Find("p"); err != nil || node.Text() != "Loading" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have rendered placeholder of view while loading")
+	}
+	tests.Passed("Should have rendered placeholder of view while loading")
+
+	close(release)
+
+	if err := feed.Load(context.Background()); err != nil {
+		tests.Failed("Should have waited for loader started by mounting view: %+q", err)
+	}
+
+	page.Flush()
+
+	if node, err := page.Find("p"); err != nil || node.Text() != "Loaded Feed" {
+		t.Logf("\t\tRecieved: %s\n", page.HTML())
+		tests.Failed("Should have re-rendered view with loaded content")
+	}
+	tests.Passed("Should have re-rendered view with loaded content")
+
+	pending := gu.Async(func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		cancelled <- struct{}{}
+		return nil, ctx.Err()
+	}, nil, nil)
+
+	app.View(pending, "/pending", gu.BodyTarget)
+
+	page.Navigate("/pending")
+	page.Navigate("/home")
+
+	<-cancelled
+	tests.Passed("Should have cancelled loader of view once unmounted")
+}
+
+func TestAsyncLoadFor(t *testing.T) {
+	var loads int32
+
+	feed := gu.Async(func(ctx context.Context) (interface{}, error) {
+		return elems.Paragraph(elems.Text("Load %d", atomic.AddInt32(&loads, 1))), nil
+	}, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := feed.Load(ctx); err != nil {
+		tests.Failed("Should have loaded content for context: %+q", err)
+	}
+
+	if content := feed.RenderFor(ctx).HTML(); !strings.Contains(content, "Load 1") {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have rendered content loaded for context")
+	}
+	tests.Passed("Should have rendered content loaded for context")
+
+	if content := feed.Render().HTML(); strings.Contains(content, "Load") {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have kept content loaded for context out of Render")
+	}
+	tests.Passed("Should have kept content loaded for context out of Render")
+
+	cancel()
+
+	if err := feed.Load(context.Background()); err != nil {
+		tests.Failed("Should have loaded content for context which is never done: %+q", err)
+	}
+
+	rendered, scoped := feed.Render().HTML(), feed.RenderFor(context.Background()).HTML()
+	if !strings.Contains(rendered, "Load 2") || rendered != scoped {
+		t.Logf("\t\tRecieved: %q and %q\n", rendered, scoped)
+		tests.Failed("Should have loaded content of Render for context which is never done")
+	}
+	tests.Passed("Should have loaded content of Render for context which is never done")
+
+	feed.Reload()
+
+	if err := feed.Load(context.Background()); err != nil {
+		tests.Failed("Should have reloaded content for context which is never done: %+q", err)
+	}
+
+	if content := feed.RenderFor(context.Background()).HTML(); !strings.Contains(content, "Load 3") {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have rendered reloaded content for context which is never done")
+	}
+	tests.Passed("Should have rendered reloaded content for context which is never done")
+}
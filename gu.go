@@ -2,11 +2,14 @@
 package gu
 
 import (
+	"context"
 	"fmt"
+	"hash/fnv"
 	"html/template"
 	"sync"
 	"sync/atomic"
 
+	"github.com/gu-io/gu/common"
 	"github.com/gu-io/gu/router"
 	"github.com/gu-io/gu/trees"
 )
@@ -22,7 +25,8 @@ var countKeeper = struct {
 }
 
 // NewKey returns a new string key which is path of the incremental key which once initializes
-// constantly increases.
+// constantly increases. Keys returned depend on the order of calls across the
+// whole process, apps, views and components use a KeyGenerator instead.
 func NewKey() string {
 	countKeeper.ml.Lock()
 	countKeeper.baseCount++
@@ -31,6 +35,38 @@ func NewKey() string {
 	return fmt.Sprintf("%d-%s", countKeeper.baseCount, countKeeper.baseKey)
 }
 
+// KeyGenerator generates deterministic keys from a seed, where each key is
+// derived from the seed, the key of its parent and the number of keys generated
+// for that parent. Generating keys for the same hierarchy with the same seed
+// always produces the same keys.
+type KeyGenerator struct {
+	seed   string
+	ml     sync.Mutex
+	counts map[string]int
+}
+
+// NewKeyGenerator returns a new KeyGenerator for the provided seed.
+func NewKeyGenerator(seed string) *KeyGenerator {
+	return &KeyGenerator{
+		seed:   seed,
+		counts: make(map[string]int),
+	}
+}
+
+// Key returns the next key for a child of the provided parent key, use an
+// empty parent for the root of the hierarchy.
+func (k *KeyGenerator) Key(parent string) string {
+	k.ml.Lock()
+	index := k.counts[parent]
+	k.counts[parent]++
+	k.ml.Unlock()
+
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%s\x00%s\x00%d", k.seed, parent, index)
+
+	return fmt.Sprintf("%016x", hash.Sum64())
+}
+
 //================================================================================
 
 // Location defines an interface which exposes a type which allows the retrieval
@@ -100,6 +136,31 @@ type ViewUpdate struct {
 	View *NView
 }
 
+// ComponentUpdate defines a struct which is used to notify the need to update
+// only a given component of a view.
+//@notification:event
+type ComponentUpdate struct {
+	App       *NApp
+	View      *NView
+	Component *Component
+}
+
+// RenderError defines a struct which is used to notify that the rendering of
+// a view or component failed, either by a panic of its Renderable or an error
+// within its markup e.g a stylesheet template. Component is nil for the base
+// of a view, UUID and Renderable identify what failed and Stack holds the
+// stack trace of a recovered panic.
+//@notification:event
+type RenderError struct {
+	App        *NApp
+	View       *NView
+	Component  *Component
+	UUID       string
+	Renderable string
+	Err        error
+	Stack      []byte
+}
+
 //================================================================================
 
 // Services defines a struct which exposes certain fields to be accessible to
@@ -113,11 +174,16 @@ type Services struct {
 	Unmounted Subscriptions
 	Router    *router.Router
 	ViewRoute router.Resolver
+
+	// Values holds the values provided by the view and its app.
+	Values *Values
 }
 
 //================================================================================
 
-// Renderable provides a interface for a renderable type.
+// Renderable provides a interface for a renderable type. Renderables rendered
+// through NApp.RenderFor are rendered by many goroutines at once, so Render must
+// be safe for concurrent use and must not modify markup shared between renders.
 type Renderable interface {
 	Render() *trees.Markup
 }
@@ -138,9 +204,10 @@ type Properties interface {
 }
 
 // Reactor defines an interface for functions subscribing for
-// notifications to react.
+// notifications to react, where the returned common.Remover unsubscribes the
+// function.
 type Reactor interface {
-	React(func())
+	React(func()) common.Remover
 }
 
 // Reactive extends the ReactiveRenderable by exposing a Publish method
@@ -150,6 +217,57 @@ type Reactive interface {
 	Publish()
 }
 
+// Mountable defines an interface for the Renderable of a view or component
+// which is notified when it is mounted, which happens for a component once its
+// view is mounted and its route matches.
+type Mountable interface {
+	Mounted()
+}
+
+// Unmountable defines an interface for the Renderable of a view or component
+// which is notified when it is unmounted, which happens when its route fails to
+// match or for a component when its view is unmounted.
+type Unmountable interface {
+	Unmounted()
+}
+
+// Updatable defines an interface for the Renderable of a view or component
+// which is notified when the mounted view, or the view of the mounted
+// component, is updated.
+type Updatable interface {
+	Updated()
+}
+
+// Composite defines an interface for the Renderable of a component which
+// declares the sub-components of the component when added into a view.
+type Composite interface {
+	Compose(*Component)
+}
+
+// ServicesReceiver defines an interface for the Renderable of a component
+// which receives the Services of the component when added into a view.
+type ServicesReceiver interface {
+	UseServices(Services)
+}
+
+// Loadable defines an interface for the Renderable of a view or component whose
+// content is loaded asynchronously, allowing renders on the server to wait for
+// the content through NApp.Load.
+type Loadable interface {
+	// Load starts loading the content if it is neither loaded nor loading,
+	// blocking until the content is loaded or the context is done.
+	Load(context.Context) error
+}
+
+// ContextRenderable defines an interface for a Renderable which renders
+// differently within the context of a render, e.g the content loaded or the
+// values provided for a request rendered through NApp.RenderFor. Renders of
+// live views and components happen within context.Background.
+type ContextRenderable interface {
+	Renderable
+	RenderFor(context.Context) *trees.Markup
+}
+
 //==============================================================================
 
 // RenderCommand defines a struct to hold a giving command for the rendering
@@ -176,6 +294,27 @@ func ViewRenderCommand(view *NView) RenderCommand {
 	}
 }
 
+// ComponentRenderCommand returns a new RenderCommand for rendering only a
+// component of a view, where the tree of the view holds the markup of the
+// component which replaces the node with its TreeID. It returns a command for
+// rendering the whole view if the component is not within the last render of
+// its view.
+func ComponentRenderCommand(component *Component) RenderCommand {
+	tree, ok := component.rerender()
+	if !ok {
+		return ViewRenderCommand(component.view)
+	}
+
+	return RenderCommand{
+		Command: "RenderComponent",
+		View: ViewJSON{
+			AppID:  component.view.appUUID,
+			ViewID: component.view.uuid,
+			Tree:   tree.TreeJSON(),
+		},
+	}
+}
+
 //==============================================================================
 
 // NewReactive returns an instance of a Reactive struct.
@@ -194,24 +333,47 @@ type Subscriptions interface {
 }
 
 // Subscription defines a baseline structure that can be composed into
-// any struct to provide a reactive view.
+// any struct to provide a reactive view. Subscribing, publishing and
+// unsubscribing are safe for concurrent use.
 type Subscription struct {
-	subs           []func()
+	ml             sync.Mutex
+	lastID         int64
+	subs           []subscriber
 	totalPublished int64
 }
 
+// subscriber defines a function subscribed to a Subscription along with the
+// id used to unsubscribe it.
+type subscriber struct {
+	id int64
+	fn func()
+}
+
 // NewSubscriptions returns an instance of a Subscription pointer.
 func NewSubscriptions() *Subscription {
 	return &Subscription{}
 }
 
-// React adds a function into the subscription list for this reactor.
-func (r *Subscription) React(sub func()) {
-	r.subs = append(r.subs, sub)
+// React adds a function into the subscription list for this reactor, returning
+// a common.Remover which removes the function from the list.
+func (r *Subscription) React(sub func()) common.Remover {
+	r.ml.Lock()
+	defer r.ml.Unlock()
+
+	r.lastID++
+	r.subs = append(r.subs, subscriber{id: r.lastID, fn: sub})
+
+	return &subscriptionRemover{
+		root: r,
+		id:   r.lastID,
+	}
 }
 
 // Clear destroys all subscribers in the lists.
 func (r *Subscription) Clear() {
+	r.ml.Lock()
+	defer r.ml.Unlock()
+
 	r.subs = nil
 }
 
@@ -226,12 +388,73 @@ func (r *Subscription) Used() bool {
 }
 
 // Publish runs a through the subscription list and calls the registerd functions.
+// Functions subscribed or unsubscribed while publishing take effect from the
+// next publish.
 func (r *Subscription) Publish() {
 	atomic.AddInt64(&r.totalPublished, 1)
 
-	for _, sub := range r.subs {
-		sub()
+	r.ml.Lock()
+	subs := r.subs
+	r.ml.Unlock()
+
+	for _, sub := range subs {
+		sub.fn()
+	}
+}
+
+// remove removes the subscriber with the id from the subscription list.
+func (r *Subscription) remove(id int64) {
+	r.ml.Lock()
+	defer r.ml.Unlock()
+
+	for index, sub := range r.subs {
+		if sub.id != id {
+			continue
+		}
+
+		// Copy the list so publishes ranging over the previous list are
+		// unaffected.
+		subs := make([]subscriber, 0, len(r.subs)-1)
+		subs = append(subs, r.subs[:index]...)
+		r.subs = append(subs, r.subs[index+1:]...)
+
+		return
 	}
 }
 
+// subscriptionRemover defines a struct which implements the common.Remover
+// interface for a function subscribed to a Subscription.
+type subscriptionRemover struct {
+	root *Subscription
+	id   int64
+	ml   sync.Mutex
+	fns  []func()
+	once sync.Once
+}
+
+// Add adds a callback to be called when Remove is called.
+func (s *subscriptionRemover) Add(fn func()) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.fns = append(s.fns, fn)
+}
+
+// Remove implements the common.Remover, unsubscribing the function and calling
+// the added callbacks once.
+func (s *subscriptionRemover) Remove() {
+	s.once.Do(func() {
+		s.root.remove(s.id)
+
+		s.ml.Lock()
+		fns := s.fns
+		s.fns = nil
+		s.ml.Unlock()
+
+		for _, fn := range fns {
+			fn()
+		}
+	})
+}
+
 //==============================================================================
@@ -0,0 +1,294 @@
+package gu_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestSubscription(t *testing.T) {
+	subs := gu.NewSubscriptions()
+
+	var first, second int
+
+	remover := subs.React(func() { first++ })
+	subs.React(func() { second++ })
+
+	var removed int
+	remover.Add(func() { removed++ })
+
+	subs.Publish()
+	remover.Remove()
+	remover.Remove()
+	subs.Publish()
+
+	if first != 1 || second != 2 {
+		t.Logf("\t\tRecieved: %d %d\n", first, second)
+		tests.Failed("Should have stopped calling only the removed subscriber")
+	}
+	tests.Passed("Should have stopped calling only the removed subscriber")
+
+	if removed != 1 {
+		t.Logf("\t\tRecieved: %d\n", removed)
+		tests.Failed("Should have called callbacks of remover once")
+	}
+	tests.Passed("Should have called callbacks of remover once")
+}
+
+func TestSubscriptionConcurrency(t *testing.T) {
+	subs := gu.NewSubscriptions()
+
+	var calls int64
+	var wg sync.WaitGroup
+
+	for index := 0; index < 20; index++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			remover := subs.React(func() { atomic.AddInt64(&calls, 1) })
+			subs.Publish()
+			remover.Remove()
+		}()
+	}
+
+	wg.Wait()
+
+	if atomic.LoadInt64(&calls) == 0 {
+		tests.Failed("Should have called subscribers while publishing concurrently")
+	}
+	tests.Passed("Should have called subscribers while publishing concurrently")
+
+	before := atomic.LoadInt64(&calls)
+	subs.Publish()
+
+	if atomic.LoadInt64(&calls) != before {
+		tests.Failed("Should have removed all subscribers concurrently")
+	}
+	tests.Passed("Should have removed all subscribers concurrently")
+}
+
+func TestThemeVariantConcurrency(t *testing.T) {
+	app := gu.App("Variants", nil)
+	app.View(elems.Div(elems.Text("Home")), "/home", gu.BodyTarget)
+
+	pe, err := router.NewPushEvent("/home", false)
+	if err != nil {
+		tests.Failed("Should have created push event: %+q", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for index := 0; index < 10; index++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			app.UseThemeVariant(common.DarkVariant)
+			app.UseLocale("ar-EG")
+		}()
+
+		go func() {
+			defer wg.Done()
+			app.RenderFor(context.Background(), pe)
+		}()
+	}
+
+	wg.Wait()
+
+	if app.ThemeVariant() != common.DarkVariant || app.Direction() != css.RTL {
+		tests.Failed("Should have switched theme variant and locale while rendering concurrently")
+	}
+	tests.Passed("Should have switched theme variant and locale while rendering concurrently")
+}
+
+// salutation defines a trees.Appliable adding a greeting into the markup it is
+// applied to.
+type salutation struct{}
+
+// Apply adds the greeting into the markup.
+func (salutation) Apply(root *trees.Markup) {
+	elems.Section(elems.Text("Applied Greeting")).Apply(root)
+}
+
+func TestConcurrentRenderFor(t *testing.T) {
+	app := gu.App("Concurrent", nil)
+	app.View(salutation{}, "/home", gu.BodyTarget)
+
+	pe, err := router.NewPushEvent("/home", false)
+	if err != nil {
+		tests.Failed("Should have created push event: %+q", err)
+	}
+
+	var wg sync.WaitGroup
+	failures := make(chan string, 40)
+
+	for index := 0; index < 20; index++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			app.AddAsset(elems.Meta(trees.NewAttr("name", "asset")), gu.HeadTarget)
+			app.View(elems.Div(elems.Text("Other")), "/other", gu.BodyTarget)
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			rc, err := app.RenderFor(context.Background(), pe)
+			if err != nil {
+				failures <- err.Error()
+				return
+			}
+
+			if content := rc.Markup.HTML(); strings.Count(content, "Applied Greeting") != 1 {
+				failures <- content
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(failures)
+
+	for failure := range failures {
+		t.Logf("\t\tRecieved: %q\n", failure)
+		tests.Failed("Should have rendered applied view once for each concurrent render")
+	}
+	tests.Passed("Should have rendered applied view once for each concurrent render")
+}
+
+func TestViewComposition(t *testing.T) {
+	app := gu.App("Composition", nil)
+
+	base := elems.Div(elems.Header1(elems.Text("Board")))
+
+	view := app.View(base, "/board", gu.BodyTarget)
+	view.Component(elems.Span(elems.Text("Composed Card")), gu.AnyOrder, "", "")
+
+	for index := 0; index < 3; index++ {
+		if content := view.Render().HTML(); strings.Count(content, "Composed Card") != 1 {
+			t.Logf("\t\tRecieved: %q\n", content)
+			tests.Failed("Should have composed component once for each render of view")
+		}
+	}
+	tests.Passed("Should have composed component once for each render of view")
+
+	children := len(base.Children())
+
+	pe, err := router.NewPushEvent("/board", false)
+	if err != nil {
+		tests.Failed("Should have created push event: %+q", err)
+	}
+
+	rc, err := app.RenderFor(context.Background(), pe)
+	if err != nil {
+		tests.Failed("Should have rendered app for request: %+q", err)
+	}
+
+	if content := rc.Markup.HTML(); strings.Count(content, "Composed Card") != 1 {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have composed component once for request render")
+	}
+	tests.Passed("Should have composed component once for request render")
+
+	if len(base.Children()) != children {
+		tests.Failed("Should have left base of view untouched by request render")
+	}
+	tests.Passed("Should have left base of view untouched by request render")
+
+	if content := view.Render().HTML(); strings.Count(content, "Composed Card") != 1 {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have composed component once after request render")
+	}
+	tests.Passed("Should have composed component once after request render")
+}
+
+// palette defines the binding of a stylesheet which counts the executions of
+// its template.
+type palette struct {
+	executions int32
+}
+
+// Color returns the color of the stylesheet.
+func (p *palette) Color() string {
+	atomic.AddInt32(&p.executions, 1)
+	return "blue"
+}
+
+type styled struct {
+	palette *palette
+}
+
+func (s styled) Render() *trees.Markup {
+	return elems.Div(elems.CSS("& { color: {{.Color}}; }", s.palette, nil), elems.Text("Styled"))
+}
+
+func TestStylesheetValidation(t *testing.T) {
+	app := gu.App("Stylesheets", nil)
+
+	colors := new(palette)
+
+	view := app.View(elems.Div(elems.Header1(elems.Text("Styles"))), "/styles", gu.BodyTarget)
+	view.Component(styled{palette: colors}, gu.AnyOrder, "", "")
+
+	if content := view.Render().HTML(); !strings.Contains(content, "color: blue") {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have rendered stylesheet of component")
+	}
+	tests.Passed("Should have rendered stylesheet of component")
+
+	if executions := atomic.LoadInt32(&colors.executions); executions != 1 {
+		t.Logf("\t\tRecieved: %d\n", executions)
+		tests.Failed("Should have executed stylesheet template once for render")
+	}
+	tests.Passed("Should have executed stylesheet template once for render")
+
+	pe, err := router.NewPushEvent("/styles", false)
+	if err != nil {
+		tests.Failed("Should have created push event: %+q", err)
+	}
+
+	rc, err := app.RenderFor(context.Background(), pe)
+	if err != nil {
+		tests.Failed("Should have rendered app for request: %+q", err)
+	}
+
+	if content := rc.Markup.HTML(); !strings.Contains(content, "color: blue") {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have rendered stylesheet of component for request")
+	}
+	tests.Passed("Should have rendered stylesheet of component for request")
+
+	if executions := atomic.LoadInt32(&colors.executions); executions != 2 {
+		t.Logf("\t\tRecieved: %d\n", executions)
+		tests.Failed("Should have validated stylesheet of component only within its own boundary")
+	}
+	tests.Passed("Should have validated stylesheet of component only within its own boundary")
+}
+
+func TestAppSeeds(t *testing.T) {
+	first, second := gu.App("Seeds", nil), gu.App("Seeds", nil)
+
+	if first.UUID() == second.UUID() {
+		tests.Failed("Should have given apps with the same title different keys")
+	}
+	tests.Passed("Should have given apps with the same title different keys")
+
+	seeded, again := gu.SeededApp("seed", "Seeds", nil), gu.SeededApp("seed", "Other", nil)
+
+	if seeded.UUID() != again.UUID() || seeded.Seed() != "seed" {
+		tests.Failed("Should have given apps with the same seed the same keys")
+	}
+	tests.Passed("Should have given apps with the same seed the same keys")
+}
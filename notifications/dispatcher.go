@@ -86,8 +86,13 @@ func (n *Notifications) UnNotify(source EventDistributor) {
 			return
 		}
 
-		n.sources[index] = nil
+		delete(n.register, source)
 		n.sources = append(n.sources[:index], n.sources[index+1:]...)
+
+		// Shift the index of the sources after the removed source.
+		for next := index; next < len(n.sources); next++ {
+			n.register[n.sources[next]] = next
+		}
 	})
 }
 
@@ -101,15 +106,20 @@ func (n *Notifications) Notify(source EventDistributor) {
 
 // Handle will publish giving type to all internal EventDistributor who are
 // expected to convert the needed interface{} into expected type for consumption
-// for their internal state or operations.
+// for their internal state or operations. The distributors are called outside
+// of the lock, so they can dispatch further notifications or unsubscribe.
 func (n *Notifications) Handle(item interface{}) {
+	var sources []EventDistributor
+
 	n.do(func() {
-		for _, source := range n.sources {
-			if source != nil {
-				source.Handle(item)
-			}
-		}
+		sources = append(sources, n.sources...)
 	})
+
+	for _, source := range sources {
+		if source != nil {
+			source.Handle(item)
+		}
+	}
 }
 
 // do performs the needed function call guarded by a mutex call block.
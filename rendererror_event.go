@@ -0,0 +1,122 @@
+package gu
+
+import "sync"
+
+// RenderErrorSubscriber defines a interface that which is used to subscribe specifically for
+// events  RenderError type.
+type RenderErrorSubscriber interface {
+	Receive(RenderError)
+}
+
+//=========================================================================================================
+
+// RenderErrorHandler defines a structure type which implements the
+// RenderErrorSubscriber interface and the EventDistributor interface.
+type RenderErrorHandler struct {
+	handle func(RenderError)
+}
+
+// NewRenderErrorHandler returns a new instance of a RenderErrorHandler.
+func NewRenderErrorHandler(fn func(RenderError)) *RenderErrorHandler {
+	return &RenderErrorHandler{
+		handle: fn,
+	}
+}
+
+// Receive takes the giving value and execute it against the underline handler.
+func (sn *RenderErrorHandler) Receive(elem RenderError) {
+	sn.handle(elem)
+}
+
+// Handle takes the giving value and asserts the expected value to match the
+// RenderError type then passes it to the Receive method.
+func (sn *RenderErrorHandler) Handle(receive interface{}) {
+	if elem, ok := receive.(RenderError); ok {
+		sn.Receive(elem)
+	}
+}
+
+//=========================================================================================================
+
+// RenderErrorNotification defines a structure type which must be used to
+// receive RenderError type has a event.
+type RenderErrorNotification struct {
+	sml        sync.Mutex
+	subs       []RenderErrorSubscriber
+	validation func(RenderError) bool
+	register   map[RenderErrorSubscriber]int
+}
+
+// NewRenderErrorNotificationWith returns a new instance of RenderErrorNotification.
+func NewRenderErrorNotificationWith(validation func(RenderError) bool) *RenderErrorNotification {
+	var elem RenderErrorNotification
+
+	elem.validation = validation
+	elem.register = make(map[RenderErrorSubscriber]int, 0)
+
+	return &elem
+}
+
+// NewRenderErrorNotification returns a new instance of NewRenderErrorNotification.
+func NewRenderErrorNotification() *RenderErrorNotification {
+	var elem RenderErrorNotification
+	elem.register = make(map[RenderErrorSubscriber]int, 0)
+
+	return &elem
+}
+
+// UnNotify removes the given subscriber from the notification's list if found from future events.
+func (sn *RenderErrorNotification) UnNotify(sub RenderErrorSubscriber) {
+	sn.do(func() {
+		index, ok := sn.register[sub]
+		if !ok {
+			return
+		}
+
+		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+	})
+}
+
+// Notify adds the given subscriber into the notification list and will await an update of
+// a new event of the given RenderError type.
+func (sn *RenderErrorNotification) Notify(sub RenderErrorSubscriber) {
+	sn.do(func() {
+		sn.register[sub] = len(sn.subs)
+		sn.subs = append(sn.subs, sub)
+	})
+}
+
+// Handle takes the giving value and asserts the expected value to be of
+// the type and pass on to it's underline subscribers else ignoring the event.
+func (sn *RenderErrorNotification) Handle(elem interface{}) {
+	if elemEvent, ok := elem.(RenderError); ok {
+		if sn.validation != nil && sn.validation(elemEvent) {
+			sn.do(func() {
+				for _, sub := range sn.subs {
+					sub.Receive(elemEvent)
+				}
+			})
+
+			return
+		}
+
+		sn.do(func() {
+			for _, sub := range sn.subs {
+				sub.Receive(elemEvent)
+			}
+		})
+	}
+}
+
+// do performs action with the mutex locked and unlocked appropriately, ensuring safe
+// concurrent access.
+func (sn *RenderErrorNotification) do(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	sn.sml.Lock()
+	defer sn.sml.Unlock()
+
+	fn()
+}
@@ -0,0 +1,152 @@
+package gu
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu/notifications"
+)
+
+// FrameInterval defines the interval between the frames of the FrameSource of
+// the Scheduler of new apps.
+var FrameInterval = time.Second / 60
+
+// FrameSource defines a source of frames on which a Scheduler flushes its
+// pending updates, e.g the animation frames of a browser.
+type FrameSource interface {
+	// Request requests the function be called on the next frame.
+	Request(func())
+}
+
+// FrameFunc defines a function which implements the FrameSource interface.
+type FrameFunc func(func())
+
+// Request implements the FrameSource interface by calling the function.
+func (f FrameFunc) Request(fn func()) {
+	f(fn)
+}
+
+// IntervalFrames returns a FrameSource whose next frame arrives once the
+// interval elapses after it is requested.
+func IntervalFrames(interval time.Duration) FrameSource {
+	return FrameFunc(func(fn func()) {
+		time.AfterFunc(interval, fn)
+	})
+}
+
+// ManualFrames defines a FrameSource which never produces frames, leaving the
+// pending updates of a Scheduler until it is flushed explicitly.
+var ManualFrames FrameSource = FrameFunc(func(func()) {})
+
+// Scheduler collects the views and components of an app which published
+// changes, notifying the need to update each of them once on the next frame
+// of its FrameSource. Many publishes of a view or component before a frame
+// result in a single update, while updates of components are dropped if their
+// view or one of their parent components updates within the same frame.
+type Scheduler struct {
+	ml         sync.Mutex
+	frames     FrameSource
+	requested  bool
+	views      []*NView
+	components []*Component
+	dirty      map[interface{}]bool
+}
+
+// NewScheduler returns a new Scheduler flushing on the frames of the provided
+// FrameSource.
+func NewScheduler(frames FrameSource) *Scheduler {
+	return &Scheduler{
+		frames: frames,
+		dirty:  make(map[interface{}]bool),
+	}
+}
+
+// Frames returns the FrameSource of the scheduler.
+func (s *Scheduler) Frames() FrameSource {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	return s.frames
+}
+
+// UseFrames sets the FrameSource of the scheduler, where updates pending
+// before the call are flushed on a frame of the previous FrameSource if one
+// was requested.
+func (s *Scheduler) UseFrames(frames FrameSource) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.frames = frames
+}
+
+// View schedules an update of the view.
+func (s *Scheduler) View(view *NView) {
+	s.schedule(view, func() {
+		s.views = append(s.views, view)
+	})
+}
+
+// Component schedules an update of the component.
+func (s *Scheduler) Component(component *Component) {
+	s.schedule(component, func() {
+		s.components = append(s.components, component)
+	})
+}
+
+// schedule adds the item to the pending updates with the provided function if
+// it is not pending, requesting a frame if none is requested.
+func (s *Scheduler) schedule(item interface{}, add func()) {
+	s.ml.Lock()
+
+	if s.dirty[item] {
+		s.ml.Unlock()
+		return
+	}
+
+	s.dirty[item] = true
+	add()
+
+	if s.requested {
+		s.ml.Unlock()
+		return
+	}
+
+	s.requested = true
+	frames := s.frames
+	s.ml.Unlock()
+
+	frames.Request(s.Flush)
+}
+
+// Flush notifies the need to update the pending views and components, where a
+// ViewUpdate is dispatched for each view and a ComponentUpdate for each
+// component whose view and parent components are not pending. Tests call
+// Flush to deliver updates without waiting for a frame.
+func (s *Scheduler) Flush() {
+	s.ml.Lock()
+	views, components, dirty := s.views, s.components, s.dirty
+	s.views = nil
+	s.components = nil
+	s.dirty = make(map[interface{}]bool)
+	s.requested = false
+	s.ml.Unlock()
+
+	for _, view := range views {
+		notifications.Dispatch(ViewUpdate{
+			App:  view.root,
+			View: view,
+		})
+	}
+
+	for _, component := range components {
+		if dirty[component.view] || component.parentDirty(dirty) {
+			continue
+		}
+
+		notifications.Dispatch(ComponentUpdate{
+			App:       component.view.root,
+			View:      component.view,
+			Component: component,
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Package server provides a http.Handler which renders a gu.NApp on the server
+// for the route of each request.
+package server
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+)
+
+// doctype is written before the rendered html of the app.
+const doctype = "<!doctype html>\n"
+
+// bufferSize defines the size of the buffer filled before the rendered html
+// is flushed to the client.
+const bufferSize = 4096
+
+// NotFoundHandler defines a function called when no view of the app matches
+// the route of a request. It is provided the route and the rendered app and
+// is responsible for writing the response.
+type NotFoundHandler func(w http.ResponseWriter, r *http.Request, pe router.PushEvent, app *trees.Markup)
+
+// Handler defines a http.Handler which renders the views of a gu.NApp matching
+// the route of each request into html, streaming the response to the client.
+// Each request is rendered with NApp.RenderFor, so requests are served
+// concurrently without affecting each other.
+type Handler struct {
+	app *gu.NApp
+
+	// Header contains headers added to every response, including error
+	// responses and those written by NotFound.
+	Header http.Header
+
+	// NotFound is called when no view matches the route of a request, if not
+	// set the app is rendered with a 404 status.
+	NotFound NotFoundHandler
+
+	// ErrorLog logs the errors met while writing the rendered html, once the
+	// response has started and its status can no longer change, e.g when the
+	// client goes away. If nil, errors are logged with the standard logger of
+	// the log package.
+	ErrorLog *log.Logger
+
+	// Await waits for the content of the Loadable views and components
+	// matching the route of a request through NApp.Load before rendering, so
+	// the html contains the loaded content instead of placeholders.
+	Await bool
+}
+
+// New returns a new Handler for the provided app.
+func New(app *gu.NApp) *Handler {
+	return &Handler{
+		app:    app,
+		Header: make(http.Header),
+	}
+}
+
+// ServeHTTP implements the http.Handler interface, rendering the app for the
+// route of the request url.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for key, values := range h.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	pe, err := PushEventFor(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Render within a context of the request alone, which releases the
+	// content loaded for the request once it is served.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	if h.Await {
+		if err := h.app.Load(ctx, pe); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	rc, err := h.app.RenderFor(ctx, pe)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	if !rc.Matched() && h.NotFound != nil {
+		h.NotFound(w, r, pe, rc.Markup)
+		return
+	}
+
+	status := http.StatusOK
+	if !rc.Matched() {
+		status = http.StatusNotFound
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	}
+
+	w.WriteHeader(status)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	if err := Write(w, rc.Markup); err != nil {
+		h.logf("server: writing response for %q: %v", r.URL.RequestURI(), err)
+	}
+}
+
+// logf logs the error through the ErrorLog of the handler if set, else through
+// the standard logger.
+func (h *Handler) logf(format string, args ...interface{}) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf(format, args...)
+		return
+	}
+
+	log.Printf(format, args...)
+}
+
+// PushEventFor returns the router.PushEvent for the url of the request.
+func PushEventFor(r *http.Request) (router.PushEvent, error) {
+	pe, err := router.NewPushEvent(r.URL.RequestURI(), false)
+	if err != nil {
+		return pe, err
+	}
+
+	pe.Host = r.Host
+	return pe, nil
+}
+
+// Write writes the doctype and the html of the markup into the writer, flushing
+// the content to the client as the buffer fills if the writer is a
+// http.Flusher.
+func Write(w io.Writer, markup *trees.Markup) error {
+	var out io.Writer = w
+	if flusher, ok := w.(http.Flusher); ok {
+		out = flushWriter{w: w, flusher: flusher}
+	}
+
+	buf := bufio.NewWriterSize(out, bufferSize)
+
+	if _, err := buf.WriteString(doctype); err != nil {
+		return err
+	}
+
+	if err := trees.SimpleElementWriter.Stream(buf, markup); err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
+
+// flushWriter flushes each write to the client.
+type flushWriter struct {
+	w       io.Writer
+	flusher http.Flusher
+}
+
+// Write writes the data and flushes it to the client.
+func (f flushWriter) Write(data []byte) (int, error) {
+	n, err := f.w.Write(data)
+	f.flusher.Flush()
+	return n, err
+}
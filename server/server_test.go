@@ -0,0 +1,236 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/server"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestHandler(t *testing.T) {
+	app := gu.App("Server Test", nil)
+	app.View(elems.Div(elems.Text("Welcome Home")), "/home", gu.BodyTarget)
+
+	handler := server.New(app)
+	handler.Header.Set("X-Rendered-By", "gu")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/home", nil))
+
+	if rec.Code != http.StatusOK {
+		tests.Failed("Should have received a %d status for matching route: %d", http.StatusOK, rec.Code)
+	}
+	tests.Passed("Should have received a %d status for matching route", http.StatusOK)
+
+	if rec.Header().Get("X-Rendered-By") != "gu" {
+		tests.Failed("Should have received handler headers")
+	}
+	tests.Passed("Should have received handler headers")
+
+	body := rec.Body.String()
+	if !strings.HasPrefix(body, "<!doctype html>") || !strings.Contains(body, "Welcome Home") {
+		t.Logf("\t\tRecieved: %q\n", body)
+		tests.Failed("Should have rendered matching view with doctype")
+	}
+	tests.Passed("Should have rendered matching view with doctype")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/missing", nil))
+
+	if rec.Code != http.StatusNotFound {
+		tests.Failed("Should have received a %d status for unknown route: %d", http.StatusNotFound, rec.Code)
+	}
+	tests.Passed("Should have received a %d status for unknown route", http.StatusNotFound)
+
+	handler.NotFound = func(w http.ResponseWriter, r *http.Request, pe router.PushEvent, _ *trees.Markup) {
+		http.Redirect(w, r, "/home", http.StatusFound)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/missing", nil))
+
+	if rec.Code != http.StatusFound {
+		tests.Failed("Should have used NotFound hook for unknown route: %d", rec.Code)
+	}
+	tests.Passed("Should have used NotFound hook for unknown route")
+
+	if rec.Header().Get("X-Rendered-By") != "gu" {
+		tests.Failed("Should have received handler headers from NotFound hook")
+	}
+	tests.Passed("Should have received handler headers from NotFound hook")
+
+	var logs bytes.Buffer
+	handler.ErrorLog = log.New(&logs, "", 0)
+
+	handler.ServeHTTP(failingWriter{ResponseRecorder: httptest.NewRecorder()}, httptest.NewRequest("GET", "/home", nil))
+
+	if !strings.Contains(logs.String(), "connection reset") {
+		t.Logf("\t\tRecieved: %q\n", logs.String())
+		tests.Failed("Should have logged the error of writing the response")
+	}
+	tests.Passed("Should have logged the error of writing the response")
+}
+
+// failingWriter defines a http.ResponseWriter whose writes fail, as when the
+// client goes away.
+type failingWriter struct {
+	*httptest.ResponseRecorder
+}
+
+// Write returns an error for all writes.
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestConcurrentHandler(t *testing.T) {
+	app := gu.App("Server Test", nil)
+	app.View(elems.Div(elems.Text("Alpha View")), "/alpha", gu.BodyTarget)
+	app.View(elems.Div(elems.Text("Beta View")), "/beta", gu.BodyTarget)
+
+	handler := server.New(app)
+
+	var wg sync.WaitGroup
+	failures := make(chan string, 100)
+
+	for i := 0; i < 50; i++ {
+		for route, content := range map[string][]string{"/alpha": {"Alpha View", "Beta View"}, "/beta": {"Beta View", "Alpha View"}} {
+			wg.Add(1)
+
+			go func(route string, want string, unwanted string) {
+				defer wg.Done()
+
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, httptest.NewRequest("GET", route, nil))
+
+				if body := rec.Body.String(); !strings.Contains(body, want) || strings.Contains(body, unwanted) {
+					failures <- route
+				}
+			}(route, content[0], content[1])
+		}
+	}
+
+	wg.Wait()
+	close(failures)
+
+	if route, ok := <-failures; ok {
+		tests.Failed("Should have rendered only the view matching %q for concurrent requests", route)
+	}
+	tests.Passed("Should have rendered only the matching views for concurrent requests")
+}
+
+func TestDeterministicRender(t *testing.T) {
+	render := func() string {
+		app := gu.SeededApp("server-test", "Server Test", nil)
+		app.AddAsset(elems.Link(trees.NewAttr("href", "/main.css")), gu.HeadTarget)
+		view := app.View(elems.Div(elems.Text("Welcome Home")), "/home", gu.BodyTarget)
+		view.Component(elems.Section(elems.Text("Profile")), gu.AnyOrder, "*", "")
+
+		pe, err := router.NewPushEvent("/home", false)
+		if err != nil {
+			tests.Failed("Should have created route: %+q", err)
+		}
+
+		rc, err := app.RenderFor(context.Background(), pe)
+		if err != nil {
+			tests.Failed("Should have rendered app: %+q", err)
+		}
+
+		var content []string
+		for _, section := range rc.Markup.Children() {
+			for _, child := range section.Children() {
+				content = append(content, child.HTML())
+			}
+		}
+
+		return strings.Join(content, "")
+	}
+
+	first, second := render(), render()
+	if first != second {
+		t.Logf("\t\tRecieved: %q\n", second)
+		t.Logf("\t\tExpected: %q\n", first)
+		tests.Failed("Should have rendered the same uids and hashes for apps with the same definition")
+	}
+	tests.Passed("Should have rendered the same uids and hashes for apps with the same definition")
+}
+
+func TestAwaitHandler(t *testing.T) {
+	app := gu.App("Await Test", nil)
+
+	view := app.View(elems.Div(elems.Text("Profile")), "/profile", gu.BodyTarget)
+	view.Component(gu.Async(func(ctx context.Context) (interface{}, error) {
+		return elems.Paragraph(elems.Text("Loaded Profile")), nil
+	}, elems.Paragraph(elems.Text("Loading")), nil), gu.AnyOrder, "*", "")
+
+	handler := server.New(app)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/profile", nil))
+
+	if !strings.Contains(rec.Body.String(), "Loading") {
+		t.Logf("\t\tRecieved: %q\n", rec.Body.String())
+		tests.Failed("Should have rendered placeholder without waiting for loaders")
+	}
+	tests.Passed("Should have rendered placeholder without waiting for loaders")
+
+	handler.Await = true
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/profile", nil))
+
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "Loaded Profile") {
+		t.Logf("\t\tRecieved: %d %q\n", rec.Code, rec.Body.String())
+		tests.Failed("Should have rendered loaded content after waiting for loaders")
+	}
+	tests.Passed("Should have rendered loaded content after waiting for loaders")
+
+	handler.Await = false
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/profile", nil))
+
+	if !strings.Contains(rec.Body.String(), "Loading") || strings.Contains(rec.Body.String(), "Loaded Profile") {
+		t.Logf("\t\tRecieved: %q\n", rec.Body.String())
+		tests.Failed("Should have kept content loaded for a request within that request")
+	}
+	tests.Passed("Should have kept content loaded for a request within that request")
+
+	handler.Await = true
+
+	blocked := gu.App("Blocked Test", nil)
+	blocked.View(gu.Async(func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, nil, nil), "/slow", gu.BodyTarget)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rec = httptest.NewRecorder()
+	handler = server.New(blocked)
+	handler.Await = true
+	handler.Header.Set("X-Rendered-By", "gu")
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/slow", nil).WithContext(ctx))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		tests.Failed("Should have failed request whose context ended while loading: %d", rec.Code)
+	}
+	tests.Passed("Should have failed request whose context ended while loading")
+
+	if rec.Header().Get("X-Rendered-By") != "gu" {
+		tests.Failed("Should have received handler headers with error response")
+	}
+	tests.Passed("Should have received handler headers with error response")
+}
@@ -0,0 +1,220 @@
+package css
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gu-io/gu/trees/css/prop"
+)
+
+// declaration defines a single property-value pair or extension declared
+// within a Style.
+type declaration struct {
+	name      prop.Property
+	value     string
+	extend    string
+	important bool
+}
+
+// Style defines a typed css block for a giving selector which is built using Go
+// methods instead of a css template. Selectors follow the same rules as
+// template based rules where "&" refers to the parent node selector, while
+// within nested styles "&" refers to the selector of the parent style.
+type Style struct {
+	selector string
+	query    func(Responsive) (string, error)
+	decls    []declaration
+	nested   []*Style
+}
+
+// Select returns a new Style for the provided selectors, which will be joined
+// together into a single selector group.
+func Select(selectors ...string) *Style {
+	return &Style{selector: strings.Join(selectors, ", ")}
+}
+
+// Media returns a new Style which wraps the provided styles within a @media
+// block using the provided query.
+func Media(query string, styles ...*Style) *Style {
+	return &Style{
+		selector: "@media " + strings.TrimSpace(query),
+		nested:   styles,
+	}
+}
+
+// Set adds the giving property and value into the style's declarations.
+func (s *Style) Set(p prop.Property, value string) *Style {
+	s.decls = append(s.decls, declaration{name: p, value: value})
+	return s
+}
+
+// SetImportant adds the giving property and value into the style's declarations
+// marked with the `!important` flag.
+func (s *Style) SetImportant(p prop.Property, value string) *Style {
+	s.decls = append(s.decls, declaration{name: p, value: value, important: true})
+	return s
+}
+
+// Extend adds the declarations of the selector item from the base styles or the
+// extension rule into the style, similar to the `extend` template function.
+func (s *Style) Extend(item string) *Style {
+	s.decls = append(s.decls, declaration{extend: item})
+	return s
+}
+
+// Add adds the provided styles as nested styles within this style. Nested
+// selector styles are written as rules following the style with "&" replaced
+// by its selector, while nested at-rules like @media and @supports are written
+// following the style and wrap its selector and declarations.
+func (s *Style) Add(styles ...*Style) *Style {
+	s.nested = append(s.nested, styles...)
+	return s
+}
+
+// Rule returns a new Rule which is built from the giving style.
+func (s *Style) Rule() *Rule {
+	return Build(s)
+}
+
+// write writes the css representation of the style into the provided buffer
+// using the rule to resolve extensions and the Responsive helpers to resolve
+// at-rule queries, returning the error of a query which failed. The parent is
+// the selector of the style the style is nested within, which is empty for the
+// styles of the rule.
+func (s *Style) write(w *bytes.Buffer, r *Rule, rs Responsive, parent string) error {
+	selector := s.selector
+	if s.query != nil {
+		query, err := s.query(rs)
+		if err != nil {
+			return err
+		}
+
+		selector = query
+	}
+
+	if !strings.HasPrefix(selector, "@") {
+		selector = nestSelector(selector, parent)
+
+		if len(s.decls) != 0 || len(s.nested) == 0 {
+			fmt.Fprintf(w, "%s {\n", selector)
+			s.writeDecls(w, r)
+			w.WriteString("}\n")
+		}
+
+		for _, nested := range s.nested {
+			if err := nested.write(w, r, rs, selector); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	fmt.Fprintf(w, "%s {\n", selector)
+
+	if len(s.decls) != 0 {
+		if parent != "" {
+			fmt.Fprintf(w, "%s {\n", parent)
+			s.writeDecls(w, r)
+			w.WriteString("}\n")
+		} else {
+			s.writeDecls(w, r)
+		}
+	}
+
+	for _, nested := range s.nested {
+		if err := nested.write(w, r, rs, parent); err != nil {
+			return err
+		}
+	}
+
+	w.WriteString("}\n")
+	return nil
+}
+
+// writeDecls writes the declarations of the style into the provided buffer
+// using the rule to resolve extensions.
+func (s *Style) writeDecls(w *bytes.Buffer, r *Rule) {
+	for _, decl := range s.decls {
+		if decl.extend != "" {
+			if attrs := r.extend(decl.extend); attrs != "" {
+				fmt.Fprintf(w, "%s\n", attrs)
+			}
+			continue
+		}
+
+		if decl.important {
+			fmt.Fprintf(w, "%s: %s !important;\n", decl.name, decl.value)
+			continue
+		}
+
+		fmt.Fprintf(w, "%s: %s;\n", decl.name, decl.value)
+	}
+}
+
+// nestSelector returns the selector of a style nested within the parent
+// selector, where "&" is replaced by each selector of the parent group, a
+// pseudo selector is appended to it and any other selector is a descendant of
+// it. The selector is returned as is if the parent is empty.
+func nestSelector(selector string, parent string) string {
+	if parent == "" {
+		return selector
+	}
+
+	var selectors []string
+
+	for _, sel := range strings.Split(selector, ",") {
+		sel = strings.TrimSpace(sel)
+
+		for _, owner := range strings.Split(parent, ",") {
+			owner = strings.TrimSpace(owner)
+
+			switch {
+			case strings.Contains(sel, "&"):
+				selectors = append(selectors, strings.Replace(sel, "&", owner, -1))
+			case strings.HasPrefix(sel, ":"):
+				selectors = append(selectors, owner+sel)
+			default:
+				selectors = append(selectors, owner+" "+sel)
+			}
+		}
+	}
+
+	return strings.Join(selectors, ", ")
+}
+
+// Build returns a new Rule which is built from the provided styles. The rule can
+// be composed with other rules through Rule.Add and Rule.UseExtension.
+func Build(styles ...*Style) *Rule {
+	return &Rule{styles: styles}
+}
+
+//==============================================================================
+
+// Px returns the value as a pixel unit.
+func Px(value int) string {
+	return strconv.Itoa(value) + "px"
+}
+
+// Em returns the value as a em unit.
+func Em(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64) + "em"
+}
+
+// Rem returns the value as a rem unit.
+func Rem(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64) + "rem"
+}
+
+// Percent returns the value as a percentage unit.
+func Percent(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64) + "%"
+}
+
+// Values joins the provided values into a single space separated value used
+// for shorthand properties e.g margin, border.
+func Values(values ...string) string {
+	return strings.Join(values, " ")
+}
@@ -10,6 +10,7 @@ import (
 
 	bcss "github.com/aymerick/douceur/css"
 	"github.com/aymerick/douceur/parser"
+	"github.com/gu-io/gu/common"
 )
 
 var (
@@ -25,8 +26,8 @@ var (
 				grade = 0
 			}
 
-			wantedColor, ok := materialPalettes[colorName]
-			if !ok {
+			wantedColor, ok := palette(colorName)
+			if !ok || len(wantedColor) == 0 {
 				return "rgba(0,0,0,1)"
 			}
 
@@ -198,6 +199,8 @@ type Rule struct {
 	depends   []*Rule
 	feedStyle *bcss.Stylesheet
 	template  *template.Template
+	styles    []*Style
+	theme     *Responsive
 }
 
 // New returns a new instance of a Rule which provides capability to parse
@@ -210,7 +213,7 @@ type Rule struct {
 func New(rules string, extension *Rule, rs ...*Rule) *Rule {
 	rsc := &Rule{depends: rs, feed: extension}
 
-	tmp, err := template.New("css").Funcs(helpers).Funcs(template.FuncMap{
+	tmp, err := template.New("css").Funcs(helpers).Funcs(defaultResponsive.funcs()).Funcs(template.FuncMap{
 		"extend": rsc.extend,
 	}).Parse(rules)
 
@@ -246,6 +249,15 @@ func (r *Rule) UseExtension(c *Rule) *Rule {
 	return r
 }
 
+// UseTheme sets the theme whose Breakpoints, BaseFontSize and BaseScale are used
+// by the responsive template functions and at-rule styles of the rule and of
+// the rules it depends on which have no theme of their own, returning the rule.
+func (r *Rule) UseTheme(theme common.Theme) *Rule {
+	rs := ResponsiveFor(theme)
+	r.theme = &rs
+	return r
+}
+
 // Add adds the giving rule into the rules depends list.
 func (r *Rule) Add(c *Rule) *Rule {
 	r.depends = append(r.depends, c)
@@ -295,8 +307,18 @@ func (r *Rule) extend(item string) string {
 // Stylesheet returns the provided styles using the binding as the argument for the
 // provided css template.
 func (r *Rule) Stylesheet(bind interface{}, parentNode string) (*bcss.Stylesheet, error) {
+	return r.stylesheet(bind, parentNode, defaultResponsive)
+}
+
+// stylesheet returns the stylesheet of the rule using the Responsive helpers of
+// the theme of the rule if set, else the inherited helpers.
+func (r *Rule) stylesheet(bind interface{}, parentNode string, rs Responsive) (*bcss.Stylesheet, error) {
+	if r.theme != nil {
+		rs = *r.theme
+	}
+
 	if r.feed != nil {
-		sheet, err := r.feed.Stylesheet(bind, parentNode)
+		sheet, err := r.feed.stylesheet(bind, parentNode, rs)
 		if err != nil {
 			return nil, err
 		}
@@ -308,7 +330,7 @@ func (r *Rule) Stylesheet(bind interface{}, parentNode string) (*bcss.Stylesheet
 
 	{
 		for _, rule := range r.depends {
-			sheet, err := rule.Stylesheet(bind, parentNode)
+			sheet, err := rule.stylesheet(bind, parentNode, rs)
 			if err != nil {
 				return nil, err
 			}
@@ -319,15 +341,27 @@ func (r *Rule) Stylesheet(bind interface{}, parentNode string) (*bcss.Stylesheet
 
 	var content bytes.Buffer
 
-	if r.template != nil {
-		if err := r.template.Execute(&content, bind); err != nil {
+	switch {
+	case r.template != nil:
+		tmpl, err := r.template.Clone()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tmpl.Funcs(rs.funcs()).Execute(&content, bind); err != nil {
 			return nil, err
 		}
-	} else {
+	case r.styles != nil:
+		for _, style := range r.styles {
+			if err := style.write(&content, r, rs, ""); err != nil {
+				return nil, err
+			}
+		}
+	default:
 		content.WriteString(r.plain)
 	}
 
-	sheet, err := parser.Parse(content.String())
+	sheet, err := parse(content.String(), 0)
 	if err != nil {
 		return nil, err
 	}
@@ -375,3 +409,146 @@ func (r *Rule) morphRule(base *bcss.Rule, parentNode string) {
 		}
 	}
 }
+
+//==============================================================================
+
+// containerAtRule defines the name of the @container at-rule, whose block holds
+// rules rather than declarations. The douceur parser only knows the blocks of
+// the at-rules of its time, so the blocks of @container rules are parsed here.
+const containerAtRule = "@container"
+
+// embedsRules returns true/false if the block of the rule holds rules instead of
+// declarations.
+func embedsRules(rule *bcss.Rule) bool {
+	return rule.EmbedsRules() || (rule.Kind == bcss.AtRule && rule.Name == containerAtRule)
+}
+
+// Parse parses the css content into a stylesheet, parsing the blocks of
+// @container rules into their nested rules which the douceur parser can not.
+func Parse(content string) (*bcss.Stylesheet, error) {
+	return parse(content, 0)
+}
+
+// parse parses the css content into a stylesheet whose rules are embedded at the
+// giving level, where the blocks of @container rules are parsed into their
+// nested rules.
+func parse(content string, level int) (*bcss.Stylesheet, error) {
+	var stylesheet bcss.Stylesheet
+
+	for {
+		block, ok, err := nextContainer(content)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			break
+		}
+
+		before, err := parser.Parse(content[:block.start])
+		if err != nil {
+			return nil, err
+		}
+
+		embed(before.Rules, level)
+
+		nested, err := parse(block.body, level+1)
+		if err != nil {
+			return nil, err
+		}
+
+		stylesheet.Rules = append(stylesheet.Rules, before.Rules...)
+		stylesheet.Rules = append(stylesheet.Rules, &bcss.Rule{
+			Kind:       bcss.AtRule,
+			Name:       containerAtRule,
+			Prelude:    block.prelude,
+			Rules:      nested.Rules,
+			EmbedLevel: level,
+		})
+
+		content = content[block.end:]
+	}
+
+	rest, err := parser.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	embed(rest.Rules, level)
+	stylesheet.Rules = append(stylesheet.Rules, rest.Rules...)
+
+	return &stylesheet, nil
+}
+
+// embed raises the embedding level of the rules and their nested rules by the
+// giving level.
+func embed(rules []*bcss.Rule, level int) {
+	for _, rule := range rules {
+		rule.EmbedLevel += level
+		embed(rule.Rules, level)
+	}
+}
+
+// containerBlock defines the position of a top-level @container rule within css
+// content along with its prelude and the content of its block.
+type containerBlock struct {
+	start   int
+	end     int
+	prelude string
+	body    string
+}
+
+// nextContainer returns the first @container rule found outside of any block,
+// comment or string within the content.
+func nextContainer(content string) (containerBlock, bool, error) {
+	var block containerBlock
+
+	depth, start, open := 0, -1, -1
+
+	for index := 0; index < len(content); index++ {
+		switch char := content[index]; {
+		case char == '/' && strings.HasPrefix(content[index:], "/*"):
+			end := strings.Index(content[index+2:], "*/")
+			if end == -1 {
+				return block, false, nil
+			}
+
+			index += end + 3
+
+		case char == '"' || char == '\'':
+			for index++; index < len(content) && content[index] != char; index++ {
+				if content[index] == '\\' {
+					index++
+				}
+			}
+
+		case char == '@' && depth == 0 && start == -1 && strings.HasPrefix(content[index:], containerAtRule):
+			start = index
+			index += len(containerAtRule) - 1
+
+		case char == '{':
+			if depth == 0 && start != -1 {
+				open = index
+			}
+
+			depth++
+
+		case char == '}':
+			depth--
+
+			if depth == 0 && open != -1 {
+				block.start = start
+				block.end = index + 1
+				block.prelude = strings.TrimSpace(content[start+len(containerAtRule) : open])
+				block.body = content[open+1 : index]
+				return block, true, nil
+			}
+		}
+	}
+
+	if start != -1 {
+		return block, false, fmt.Errorf("Unterminated %s rule", containerAtRule)
+	}
+
+	return block, false, nil
+}
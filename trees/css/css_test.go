@@ -1,9 +1,13 @@
 package css_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/themes/materialcolors"
 	"github.com/gu-io/gu/trees/css"
+	"github.com/gu-io/gu/trees/css/prop"
 	"github.com/influx6/faux/tests"
 )
 
@@ -155,3 +159,346 @@ func TestLinkedCSS(t *testing.T) {
 	}
 	tests.Passed("Should have rendered expected stylesheet")
 }
+
+func TestBuilderCSS(t *testing.T) {
+	expected := "#galatica:hover {\n  color: red;\n}\n#galatica::before {\n  content: \"bugger\";\n}\n#galatica div a {\n  color: black;\n  font-family: Helvetica;\n}\n@media (max-width: 400px) {\n  #galatica:hover {\n    color: blue;\n    font-family: Helvetica;\n  }\n}"
+
+	csr := css.Build(
+		css.Select("&:hover").Set(prop.Color, "red"),
+		css.Select("&::before").Set(prop.Content, `"bugger"`),
+		css.Select("& div a").Set(prop.Color, "black").Set(prop.FontFamily, "Helvetica"),
+		css.Media("(max-width: 400px)",
+			css.Select("&:hover").Set(prop.Color, "blue").Set(prop.FontFamily, "Helvetica"),
+		),
+	)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for built rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for built rule")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected stylesheet")
+	}
+	tests.Passed("Should have rendered expected stylesheet")
+}
+
+func TestNestedBuilderCSS(t *testing.T) {
+	expected := "#galatica .card {\n  color: red;\n}\n#galatica .card:hover {\n  color: blue;\n}\n@media (max-width: 400px) {\n  #galatica .card {\n    padding: 0;\n  }\n  #galatica .card p {\n    margin: 0;\n  }\n}"
+
+	csr := css.Build(
+		css.Select("& .card").Set(prop.Color, "red").Add(
+			css.Select("&:hover").Set(prop.Color, "blue"),
+			css.Media("(max-width: 400px)",
+				css.Select("p").Set(prop.Margin, "0"),
+			).Set(prop.Padding, "0"),
+		),
+	)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for nested styles: %+q", err)
+	}
+	tests.Passed("Should have successfully processed stylesheet for nested styles")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered nested styles as sibling rules")
+	}
+	tests.Passed("Should have rendered nested styles as sibling rules")
+}
+
+func TestBuilderExtensionCSS(t *testing.T) {
+	expected := "block {\n  font-family: Helvetica;\n  color: Pink;\n}\ndiv a {\n  font-family: Helvetica;\n  color: Pink;\n  border: 1px solid #000;\n}"
+
+	csr := css.New(`
+    block {
+      font-family: {{ .Font }};
+      color: {{ .Color }};
+    }
+  `, nil)
+
+	csx := css.Build(
+		css.Select("div a").Extend("block").Set(prop.Border, css.Values(css.Px(1), "solid", "#000")),
+	).UseExtension(csr).Add(csr)
+
+	sheet, err := csx.Stylesheet(struct {
+		Font  string
+		Color string
+	}{
+		Font:  "Helvetica",
+		Color: "Pink",
+	}, "#galatica")
+
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for built rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for built rule")
+
+	if res := sheet.String(); res != expected {
+		t.Logf("\t\tRecieved: %q\n", res)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected stylesheet")
+	}
+	tests.Passed("Should have rendered expected stylesheet")
+}
+
+func TestResponsiveCSS(t *testing.T) {
+	expected := "@media (min-width: 768px) {\n  #galatica div {\n    padding: 0.5rem;\n    font-size: 1.25rem;\n  }\n}\n@container card (min-width: 480px) {\n  #galatica p {\n    margin: 0.625rem;\n  }\n}"
+
+	theme := common.Theme{
+		BaseScale:   1.25,
+		Breakpoints: map[string]int{"phone": 480, "tablet": 768},
+	}
+
+	rs := css.ResponsiveFor(theme)
+
+	csr := css.New(`
+    {{ media "tablet" }} {
+      & div {
+        padding: {{ spacing 0 }};
+        font-size: {{ typeScale 1 }};
+      }
+    }
+
+    {{ containerNamed "card" "phone" }} {
+      & p {
+        margin: {{ spacing 1 }};
+      }
+    }
+`, nil).UseTheme(theme)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule: %+q", err)
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := css.Format(sheet); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected stylesheet")
+	}
+	tests.Passed("Should have rendered expected stylesheet")
+
+	built := css.Build(
+		css.MediaUp("tablet",
+			css.Select("& div").Set(prop.Padding, rs.Spacing(0)).Set(prop.FontSize, rs.TypeScale(1)),
+		),
+		css.Container("card", "phone",
+			css.Select("& p").Set(prop.Margin, rs.Spacing(1)),
+		),
+	).UseTheme(theme)
+
+	sheet, err = built.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for built rule: %+q", err)
+	}
+	tests.Passed("Should have successfully processed stylesheet for built rule")
+
+	if val := css.Format(sheet); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected stylesheet")
+	}
+	tests.Passed("Should have rendered expected stylesheet")
+
+	if _, err := css.New(`{{ media "watch" }} { & { color: red; } }`, nil).Stylesheet(nil, "#galatica"); err == nil {
+		tests.Failed("Should have failed to process stylesheet with unknown breakpoint")
+	}
+	tests.Passed("Should have failed to process stylesheet with unknown breakpoint")
+
+	if _, err := css.Build(css.MediaUp("watch", css.Select("& p"))).Stylesheet(nil, "#galatica"); err == nil {
+		tests.Failed("Should have failed to process built stylesheet with unknown breakpoint")
+	}
+	tests.Passed("Should have failed to process built stylesheet with unknown breakpoint")
+
+	if _, err := css.New(`{{ media "tablet" }} { & { color: red; } }`, nil).Stylesheet(nil, "#galatica"); err != nil {
+		tests.Failed("Should have processed stylesheet with default breakpoints: %+q", err)
+	}
+	tests.Passed("Should have processed stylesheet with default breakpoints")
+}
+
+func TestContainerCSS(t *testing.T) {
+	expected := "#galatica{container-type:inline-size}@container (min-width: 480px){#galatica p{margin:0}@container card (min-width: 768px){#galatica span{content:\"}\"}}}#galatica a{color:red}"
+
+	csr := css.Plain(`
+    & {
+      container-type: inline-size;
+    }
+
+    /* @container { is ignored within comments */
+    @container (min-width: 480px) {
+      & p {
+        margin: 0;
+      }
+
+      @container card (min-width: 768px) {
+        & span {
+          content: "}";
+        }
+      }
+    }
+
+    & a {
+      color: red;
+    }
+`, nil)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet with container rules: %+q", err)
+	}
+	tests.Passed("Should have successfully processed stylesheet with container rules")
+
+	if val := css.Minify(sheet); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered rules nested within container rules")
+	}
+	tests.Passed("Should have rendered rules nested within container rules")
+
+	if _, err := css.Plain(`@container (min-width: 480px) { & p { margin: 0; }`, nil).Stylesheet(nil, "#galatica"); err == nil {
+		tests.Failed("Should have failed to process stylesheet with unterminated container rule")
+	}
+	tests.Passed("Should have failed to process stylesheet with unterminated container rule")
+}
+
+func TestProcessedCSS(t *testing.T) {
+	expected := "#galatica div{display:-webkit-flex;display:flex;color:red;-webkit-transform:scale(2);transform:scale(2)}@media (min-width: 400px){#galatica p{-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none}}"
+
+	csr := css.New(`
+    & div {
+      display: flex;
+      color: red;
+      color: red;
+    }
+
+    & div {
+      transform: scale(2);
+    }
+
+    @media (min-width: 400px){
+      & p {
+        user-select: none;
+      }
+    }
+`, nil)
+
+	targets, err := css.ParseTargets("safari >= 8", "ie 11", "firefox 60", "chrome 60")
+	if err != nil {
+		tests.Failed("Should have successfully parsed browser targets: %+q", err)
+	}
+	tests.Passed("Should have successfully parsed browser targets")
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	processor := css.NewProcessor(targets...)
+
+	if val := processor.Print(sheet); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected processed stylesheet")
+	}
+	tests.Passed("Should have rendered expected processed stylesheet")
+}
+
+func TestPaletteCSS(t *testing.T) {
+	expected := "#galatica div {\n  color: rgba(33,150,243,1);\n  border-color: rgba(0,0,0,1);\n}"
+
+	palettes, err := materialcolors.Generate("Brand", "#2196f3")
+	if err != nil {
+		tests.Failed("Should have successfully generated palettes from seed color: %+q", err)
+	}
+	tests.Passed("Should have successfully generated palettes from seed color")
+
+	for _, name := range []string{"brand", "brand-neutral", "brand-accent"} {
+		if len(palettes[name]) != len(materialcolors.MaterialPalettes["blue"]) {
+			tests.Failed("Should have generated %q palette with material grades", name)
+		}
+	}
+	tests.Passed("Should have generated palettes with material grades")
+
+	css.RegisterPalettes(palettes)
+
+	csr := css.New(`
+    & div {
+      color: {{ materialColors "brand" 5 }};
+      border-color: {{ materialColors "unknown-brand" 5 }};
+    }
+`, nil)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected stylesheet")
+	}
+	tests.Passed("Should have rendered expected stylesheet")
+}
+
+func TestRTLCSS(t *testing.T) {
+	expected := "#galatica div {\n  margin-right: 10px;\n  float: right;\n  text-align: left;\n  padding: 1px 4px 3px 2px;\n  border-radius: 2px 1px 4px 3px;\n  -webkit-border-top-right-radius: 2px;\n  --side-left: 1px;\n  margin-inline-start: 2px;\n}"
+
+	csr := css.New(`
+    & div {
+      margin-left: 10px;
+      float: left;
+      text-align: right;
+      padding: 1px 2px 3px 4px;
+      border-radius: 1px 2px 3px 4px;
+      -webkit-border-top-left-radius: 2px;
+      --side-left: 1px;
+      {{ marginStart "2px" }}
+    }
+`, nil)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if dir := css.DirectionFor("ar-EG"); dir != css.RTL {
+		tests.Failed("Should have received RTL direction for arabic locale")
+	}
+	tests.Passed("Should have received RTL direction for arabic locale")
+
+	if val := css.PrintDirection(sheet, css.RTL); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered flipped stylesheet")
+	}
+	tests.Passed("Should have rendered flipped stylesheet")
+
+	targeted := css.New(`
+    [dir="rtl"] & span {
+      margin-right: 10px;
+    }
+`, nil)
+
+	sheet, err = targeted.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := css.PrintDirection(sheet, css.RTL); !strings.Contains(val, "margin-right: 10px;") {
+		t.Logf("\t\tRecieved: %q\n", val)
+		tests.Failed("Should have left rules targeting a direction unflipped")
+	}
+	tests.Passed("Should have left rules targeting a direction unflipped")
+}
@@ -0,0 +1,48 @@
+package css
+
+import (
+	"strings"
+	"sync"
+)
+
+// palettes holds the custom palettes registered for the `materialColors`
+// template function. Guards access using a mutex as palettes can be
+// registered at runtime.
+var palettes = struct {
+	ml     sync.RWMutex
+	custom map[string][]string
+}{
+	custom: make(map[string][]string),
+}
+
+// RegisterPalette adds the palette under the giving name for use with the
+// `materialColors` template function, where each grade is a "r,g,b" value. A
+// registered palette takes precedence over a material palette of the same name.
+func RegisterPalette(name string, grades []string) {
+	palettes.ml.Lock()
+	palettes.custom[strings.ToLower(name)] = grades
+	palettes.ml.Unlock()
+}
+
+// RegisterPalettes adds all the provided palettes for use with the
+// `materialColors` template function e.g the palettes from materialcolors.Generate.
+func RegisterPalettes(set map[string][]string) {
+	for name, grades := range set {
+		RegisterPalette(name, grades)
+	}
+}
+
+// palette returns the grades of the registered or material palette with the
+// giving name.
+func palette(name string) ([]string, bool) {
+	palettes.ml.RLock()
+	grades, ok := palettes.custom[name]
+	palettes.ml.RUnlock()
+
+	if ok {
+		return grades, true
+	}
+
+	grades, ok = materialPalettes[name]
+	return grades, ok
+}
@@ -0,0 +1,489 @@
+package css
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	bcss "github.com/aymerick/douceur/css"
+)
+
+// Target defines a browser and the minimum version of it which a stylesheet
+// must support.
+type Target struct {
+	Browser string
+	Version int
+}
+
+// DefaultTargets defines the browser targets used by a Processor when none are
+// provided.
+var DefaultTargets = []Target{
+	{Browser: "chrome", Version: 49},
+	{Browser: "firefox", Version: 45},
+	{Browser: "safari", Version: 9},
+	{Browser: "ios", Version: 9},
+	{Browser: "edge", Version: 12},
+	{Browser: "ie", Version: 11},
+}
+
+// ParseTargets returns the Targets described by the provided list, where each
+// entry is in the form of `<browser> >= <version>` or `<browser> <version>`.
+func ParseTargets(list ...string) ([]Target, error) {
+	var targets []Target
+
+	for _, item := range list {
+		fields := strings.Fields(strings.ToLower(item))
+
+		if len(fields) == 3 && fields[1] == ">=" {
+			fields = []string{fields[0], fields[2]}
+		}
+
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("Invalid browser target %q", item)
+		}
+
+		version, err := strconv.Atoi(strings.Split(fields[1], ".")[0])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid browser target %q: %s", item, err.Error())
+		}
+
+		targets = append(targets, Target{Browser: fields[0], Version: version})
+	}
+
+	return targets, nil
+}
+
+// prefixSupport defines a vendor prefix needed by a browser below a giving
+// version.
+type prefixSupport struct {
+	prefix  string
+	browser string
+	below   int
+}
+
+// propertyPrefixes defines the vendor prefixed properties generated for standard
+// properties based on the versions of browsers which require them.
+var propertyPrefixes = map[string][]prefixSupport{
+	"animation":                  {{"-webkit-", "chrome", 43}, {"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"animation-delay":            {{"-webkit-", "chrome", 43}, {"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"animation-duration":         {{"-webkit-", "chrome", 43}, {"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"animation-name":             {{"-webkit-", "chrome", 43}, {"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"animation-timing-function":  {{"-webkit-", "chrome", 43}, {"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"appearance":                 {{"-webkit-", "chrome", 84}, {"-webkit-", "safari", 15}, {"-webkit-", "ios", 15}, {"-moz-", "firefox", 80}},
+	"backdrop-filter":            {{"-webkit-", "safari", 18}, {"-webkit-", "ios", 18}},
+	"box-sizing":                 {{"-webkit-", "safari", 5}, {"-moz-", "firefox", 29}},
+	"clip-path":                  {{"-webkit-", "chrome", 55}, {"-webkit-", "safari", 13}, {"-webkit-", "ios", 13}},
+	"flex":                       {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}, {"-ms-", "ie", 11}},
+	"flex-basis":                 {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"flex-direction":             {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}, {"-ms-", "ie", 11}},
+	"flex-grow":                  {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"flex-shrink":                {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}},
+	"flex-wrap":                  {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}, {"-ms-", "ie", 11}},
+	"hyphens":                    {{"-webkit-", "safari", 17}, {"-webkit-", "ios", 17}, {"-moz-", "firefox", 43}, {"-ms-", "ie", 12}, {"-ms-", "edge", 79}},
+	"mask":                       {{"-webkit-", "chrome", 120}, {"-webkit-", "safari", 15}, {"-webkit-", "ios", 15}},
+	"text-size-adjust":           {{"-webkit-", "safari", 99}, {"-webkit-", "ios", 99}, {"-ms-", "edge", 79}},
+	"transform":                  {{"-webkit-", "chrome", 36}, {"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}, {"-ms-", "ie", 10}},
+	"transform-origin":           {{"-webkit-", "chrome", 36}, {"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}, {"-ms-", "ie", 10}},
+	"transition":                 {{"-webkit-", "chrome", 26}, {"-webkit-", "safari", 7}, {"-webkit-", "ios", 7}},
+	"transition-duration":        {{"-webkit-", "chrome", 26}, {"-webkit-", "safari", 7}, {"-webkit-", "ios", 7}},
+	"transition-property":        {{"-webkit-", "chrome", 26}, {"-webkit-", "safari", 7}, {"-webkit-", "ios", 7}},
+	"transition-timing-function": {{"-webkit-", "chrome", 26}, {"-webkit-", "safari", 7}, {"-webkit-", "ios", 7}},
+	"user-select":                {{"-webkit-", "chrome", 54}, {"-webkit-", "safari", 99}, {"-webkit-", "ios", 99}, {"-moz-", "firefox", 69}, {"-ms-", "ie", 12}, {"-ms-", "edge", 79}},
+}
+
+// valuePrefixes defines the vendor prefixed values generated for standard values
+// of a giving property based on the versions of browsers which require them.
+var valuePrefixes = map[string]map[string][]prefixSupport{
+	"display": {
+		"flex":        {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}, {"-ms-", "ie", 11}},
+		"inline-flex": {{"-webkit-", "safari", 9}, {"-webkit-", "ios", 9}, {"-ms-", "ie", 11}},
+	},
+	"position": {
+		"sticky": {{"-webkit-", "safari", 13}, {"-webkit-", "ios", 13}},
+	},
+}
+
+// msFlexValues defines the IE10/IE11 names for the flex display values.
+var msFlexValues = map[string]string{
+	"flex":        "flexbox",
+	"inline-flex": "inline-flexbox",
+}
+
+// Processor defines a post-processing stage for stylesheets which adds vendor
+// prefixes for the provided browser targets, removes duplicate declarations,
+// merges rules with identical selectors and can minify the printed output.
+type Processor struct {
+	Targets    []Target
+	Prefix     bool
+	Dedupe     bool
+	MergeRules bool
+	Minify     bool
+}
+
+// NewProcessor returns a new Processor with all processing stages enabled for
+// the provided browser targets, using the DefaultTargets if none are provided.
+func NewProcessor(targets ...Target) *Processor {
+	return &Processor{
+		Targets:    targets,
+		Prefix:     true,
+		Dedupe:     true,
+		MergeRules: true,
+		Minify:     true,
+	}
+}
+
+// Process runs the stylesheet through the enabled stages of the processor,
+// modifying the rules of the stylesheet in place.
+func (p *Processor) Process(sheet *bcss.Stylesheet) *bcss.Stylesheet {
+	sheet.Rules = p.processRules(sheet.Rules)
+	return sheet
+}
+
+// Print processes the stylesheet and returns its string representation, which
+// is minified if the processor has Minify set.
+func (p *Processor) Print(sheet *bcss.Stylesheet) string {
+	p.Process(sheet)
+
+	if p.Minify {
+		return Minify(sheet)
+	}
+
+	return Format(sheet)
+}
+
+// processRules processes the provided rules and any nested rules within them.
+func (p *Processor) processRules(rules []*bcss.Rule) []*bcss.Rule {
+	for _, rule := range rules {
+		if embedsRules(rule) {
+			rule.Rules = p.processRules(rule.Rules)
+			continue
+		}
+
+		if p.Prefix {
+			rule.Declarations = p.prefix(rule.Declarations)
+		}
+
+		if p.Dedupe {
+			rule.Declarations = dedupe(rule.Declarations)
+		}
+	}
+
+	if p.MergeRules {
+		rules = merge(rules)
+	}
+
+	return rules
+}
+
+// needs returns true/false if any of the browser targets of the processor are
+// below the version of the browser in the provided support.
+func (p *Processor) needs(support prefixSupport) bool {
+	targets := p.Targets
+	if len(targets) == 0 {
+		targets = DefaultTargets
+	}
+
+	for _, target := range targets {
+		if target.Browser == support.browser && target.Version < support.below {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prefix returns a new list of declarations with the needed vendor prefixed
+// declarations added before their standard declaration.
+func (p *Processor) prefix(decls []*bcss.Declaration) []*bcss.Declaration {
+	var prefixed []*bcss.Declaration
+
+	for _, decl := range decls {
+		seen := make(map[string]bool)
+
+		for _, support := range propertyPrefixes[decl.Property] {
+			if seen[support.prefix] || !p.needs(support) {
+				continue
+			}
+
+			seen[support.prefix] = true
+			prefixed = append(prefixed, &bcss.Declaration{
+				Property:  support.prefix + decl.Property,
+				Value:     decl.Value,
+				Important: decl.Important,
+			})
+		}
+
+		if values, ok := valuePrefixes[decl.Property]; ok {
+			for _, support := range values[strings.TrimSpace(decl.Value)] {
+				if seen[support.prefix] || !p.needs(support) {
+					continue
+				}
+
+				value := decl.Value
+				if support.prefix == "-ms-" {
+					if msValue, ok := msFlexValues[value]; ok {
+						value = msValue
+					}
+				}
+
+				seen[support.prefix] = true
+				prefixed = append(prefixed, &bcss.Declaration{
+					Property:  decl.Property,
+					Value:     support.prefix + value,
+					Important: decl.Important,
+				})
+			}
+		}
+
+		prefixed = append(prefixed, decl)
+	}
+
+	return prefixed
+}
+
+// dedupe removes declarations which are repeated later in the list with the same
+// property and value, keeping the last of them. Declarations of the same property
+// with different values are kept as they are used as fallbacks.
+func dedupe(decls []*bcss.Declaration) []*bcss.Declaration {
+	var deduped []*bcss.Declaration
+
+	for index, decl := range decls {
+		var repeated bool
+
+		for _, other := range decls[index+1:] {
+			if decl.Equal(other) {
+				repeated = true
+				break
+			}
+		}
+
+		if !repeated {
+			deduped = append(deduped, decl)
+		}
+	}
+
+	return deduped
+}
+
+// merge merges qualified rules with identical selectors into the first of them,
+// as long as no rule in between declares any of the moved properties, which
+// would change the cascade.
+func merge(rules []*bcss.Rule) []*bcss.Rule {
+	var merged []*bcss.Rule
+
+	for _, rule := range rules {
+		if rule.Kind != bcss.QualifiedRule || !mergeInto(merged, rule) {
+			merged = append(merged, rule)
+		}
+	}
+
+	return merged
+}
+
+// mergeInto attempts to merge the rule into a previous rule with the same
+// selectors, returning true if it did so.
+func mergeInto(previous []*bcss.Rule, rule *bcss.Rule) bool {
+	for index := len(previous) - 1; index >= 0; index-- {
+		prev := previous[index]
+
+		if prev.Kind == bcss.QualifiedRule && strings.Join(prev.Selectors, ",") == strings.Join(rule.Selectors, ",") {
+			prev.Declarations = dedupe(append(prev.Declarations, rule.Declarations...))
+			return true
+		}
+
+		if sharesProperties(prev, rule) {
+			return false
+		}
+	}
+
+	return false
+}
+
+// sharesProperties returns true/false if the first rule or any of its nested
+// rules declare a property which is declared by the second rule.
+func sharesProperties(rule *bcss.Rule, other *bcss.Rule) bool {
+	for _, nested := range rule.Rules {
+		if sharesProperties(nested, other) {
+			return true
+		}
+	}
+
+	for _, decl := range rule.Declarations {
+		for _, odecl := range other.Declarations {
+			if decl.Property == odecl.Property {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// Minify returns the string representation of the stylesheet with all
+// unnecessary whitespace and separators removed.
+func Minify(sheet *bcss.Stylesheet) string {
+	var content bytes.Buffer
+
+	for _, rule := range sheet.Rules {
+		minifyRule(&content, rule)
+	}
+
+	return content.String()
+}
+
+// minifyRule writes the minified representation of the rule into the buffer.
+func minifyRule(w *bytes.Buffer, rule *bcss.Rule) {
+	if rule.Kind == bcss.QualifiedRule {
+		for index, sel := range rule.Selectors {
+			if index != 0 {
+				w.WriteString(",")
+			}
+
+			w.WriteString(strings.TrimSpace(sel))
+		}
+	} else {
+		w.WriteString(rule.Name)
+
+		if rule.Prelude != "" {
+			w.WriteString(" ")
+			w.WriteString(strings.TrimSpace(rule.Prelude))
+		}
+	}
+
+	if len(rule.Declarations) == 0 && len(rule.Rules) == 0 {
+		w.WriteString(";")
+		return
+	}
+
+	w.WriteString("{")
+
+	if embedsRules(rule) {
+		for _, nested := range rule.Rules {
+			minifyRule(w, nested)
+		}
+	} else {
+		for index, decl := range rule.Declarations {
+			if index != 0 {
+				w.WriteString(";")
+			}
+
+			w.WriteString(decl.Property)
+			w.WriteString(":")
+			w.WriteString(strings.TrimSpace(decl.Value))
+
+			if decl.Important {
+				w.WriteString("!important")
+			}
+		}
+	}
+
+	w.WriteString("}")
+}
+
+//==============================================================================
+
+// Format returns the indented string representation of the stylesheet, which
+// matches the representation of the douceur stylesheet while also writing the
+// rules nested within @container rules.
+func Format(sheet *bcss.Stylesheet) string {
+	var content bytes.Buffer
+
+	for index, rule := range sheet.Rules {
+		if index != 0 {
+			content.WriteString("\n")
+		}
+
+		formatRule(&content, rule, 0)
+	}
+
+	return content.String()
+}
+
+// formatRule writes the indented representation of the rule embedded at the
+// giving level into the buffer.
+func formatRule(w *bytes.Buffer, rule *bcss.Rule, level int) {
+	if rule.Kind == bcss.QualifiedRule {
+		w.WriteString(strings.Join(rule.Selectors, ", "))
+	} else {
+		w.WriteString(rule.Name)
+
+		if rule.Prelude != "" {
+			w.WriteString(" ")
+			w.WriteString(rule.Prelude)
+		}
+	}
+
+	if len(rule.Declarations) == 0 && len(rule.Rules) == 0 {
+		w.WriteString(";")
+		return
+	}
+
+	w.WriteString(" {\n")
+
+	indent := strings.Repeat(" ", (level+1)*2)
+
+	if embedsRules(rule) {
+		for _, nested := range rule.Rules {
+			w.WriteString(indent)
+			formatRule(w, nested, level+1)
+			w.WriteString("\n")
+		}
+	} else {
+		for _, decl := range rule.Declarations {
+			w.WriteString(indent)
+			w.WriteString(decl.String())
+			w.WriteString("\n")
+		}
+	}
+
+	w.WriteString(strings.Repeat(" ", level*2))
+	w.WriteString("}")
+}
+
+//==============================================================================
+
+// processing holds the Processor used by Print, guarded by a mutex as it can
+// be changed at runtime.
+var processing = struct {
+	ml        sync.RWMutex
+	processor *Processor
+}{}
+
+// UseProcessor sets the Processor used by Print when rendering stylesheets at
+// runtime, providing nil disables post-processing.
+func UseProcessor(p *Processor) {
+	processing.ml.Lock()
+	processing.processor = p
+	processing.ml.Unlock()
+}
+
+// Print returns the string representation of the stylesheet after running it
+// through the Processor set with UseProcessor if any.
+func Print(sheet *bcss.Stylesheet) string {
+	return PrintDirection(sheet, LTR)
+}
+
+// PrintDirection returns the string representation of the stylesheet for the
+// giving writing direction after running it through the Processor set with
+// UseProcessor if any. The direction of a stylesheet rendered by
+// trees.CSSStylesheet comes from the `dir` attribute of its element or closest
+// parent, which views set from the locale of their app.
+func PrintDirection(sheet *bcss.Stylesheet, dir Direction) string {
+	if dir == RTL {
+		Flip(sheet)
+	}
+
+	processing.ml.RLock()
+	processor := processing.processor
+	processing.ml.RUnlock()
+
+	if processor == nil {
+		return Format(sheet)
+	}
+
+	return processor.Print(sheet)
+}
@@ -0,0 +1,184 @@
+// Package prop provides typed css property names which are used by the css
+// builder to ensure property names are checked by the compiler instead of
+// being discovered as parse errors at runtime.
+package prop
+
+// Property defines a css property name used when declaring styles.
+type Property string
+
+// String returns the css name of the property.
+func (p Property) String() string {
+	return string(p)
+}
+
+// Custom returns a Property for the provided name, it is used for properties
+// not declared within this package e.g vendor prefixed or custom properties.
+func Custom(name string) Property {
+	return Property(name)
+}
+
+// Box model and layout properties.
+const (
+	Display        Property = "display"
+	Position       Property = "position"
+	Top            Property = "top"
+	Right          Property = "right"
+	Bottom         Property = "bottom"
+	Left           Property = "left"
+	Float          Property = "float"
+	Clear          Property = "clear"
+	ZIndex         Property = "z-index"
+	Overflow       Property = "overflow"
+	OverflowX      Property = "overflow-x"
+	OverflowY      Property = "overflow-y"
+	Visibility     Property = "visibility"
+	BoxSizing      Property = "box-sizing"
+	Width          Property = "width"
+	MinWidth       Property = "min-width"
+	MaxWidth       Property = "max-width"
+	Height         Property = "height"
+	MinHeight      Property = "min-height"
+	MaxHeight      Property = "max-height"
+	Margin         Property = "margin"
+	MarginTop      Property = "margin-top"
+	MarginRight    Property = "margin-right"
+	MarginBottom   Property = "margin-bottom"
+	MarginLeft     Property = "margin-left"
+	Padding        Property = "padding"
+	PaddingTop     Property = "padding-top"
+	PaddingRight   Property = "padding-right"
+	PaddingBottom  Property = "padding-bottom"
+	PaddingLeft    Property = "padding-left"
+	VerticalAlign  Property = "vertical-align"
+	ContainerType  Property = "container-type"
+	ContainerName  Property = "container-name"
+	AspectRatio    Property = "aspect-ratio"
+	ObjectFit      Property = "object-fit"
+	ObjectPosition Property = "object-position"
+)
+
+// Logical properties which follow the writing direction of the document.
+const (
+	MarginInlineStart      Property = "margin-inline-start"
+	MarginInlineEnd        Property = "margin-inline-end"
+	PaddingInlineStart     Property = "padding-inline-start"
+	PaddingInlineEnd       Property = "padding-inline-end"
+	InsetInlineStart       Property = "inset-inline-start"
+	InsetInlineEnd         Property = "inset-inline-end"
+	BorderInlineStart      Property = "border-inline-start"
+	BorderInlineEnd        Property = "border-inline-end"
+	BorderStartStartRadius Property = "border-start-start-radius"
+	BorderStartEndRadius   Property = "border-start-end-radius"
+	BorderEndStartRadius   Property = "border-end-start-radius"
+	BorderEndEndRadius     Property = "border-end-end-radius"
+)
+
+// Flexbox and grid properties.
+const (
+	Flex                Property = "flex"
+	FlexBasis           Property = "flex-basis"
+	FlexDirection       Property = "flex-direction"
+	FlexFlow            Property = "flex-flow"
+	FlexGrow            Property = "flex-grow"
+	FlexShrink          Property = "flex-shrink"
+	FlexWrap            Property = "flex-wrap"
+	Order               Property = "order"
+	AlignContent        Property = "align-content"
+	AlignItems          Property = "align-items"
+	AlignSelf           Property = "align-self"
+	JustifyContent      Property = "justify-content"
+	JustifyItems        Property = "justify-items"
+	JustifySelf         Property = "justify-self"
+	Gap                 Property = "gap"
+	RowGap              Property = "row-gap"
+	ColumnGap           Property = "column-gap"
+	Grid                Property = "grid"
+	GridArea            Property = "grid-area"
+	GridAutoColumns     Property = "grid-auto-columns"
+	GridAutoFlow        Property = "grid-auto-flow"
+	GridAutoRows        Property = "grid-auto-rows"
+	GridColumn          Property = "grid-column"
+	GridRow             Property = "grid-row"
+	GridTemplate        Property = "grid-template"
+	GridTemplateAreas   Property = "grid-template-areas"
+	GridTemplateColumns Property = "grid-template-columns"
+	GridTemplateRows    Property = "grid-template-rows"
+)
+
+// Typography properties.
+const (
+	Color          Property = "color"
+	Font           Property = "font"
+	FontFamily     Property = "font-family"
+	FontSize       Property = "font-size"
+	FontStyle      Property = "font-style"
+	FontWeight     Property = "font-weight"
+	FontVariant    Property = "font-variant"
+	LineHeight     Property = "line-height"
+	LetterSpacing  Property = "letter-spacing"
+	WordSpacing    Property = "word-spacing"
+	WordWrap       Property = "word-wrap"
+	WordBreak      Property = "word-break"
+	WhiteSpace     Property = "white-space"
+	TextAlign      Property = "text-align"
+	TextDecoration Property = "text-decoration"
+	TextIndent     Property = "text-indent"
+	TextOverflow   Property = "text-overflow"
+	TextShadow     Property = "text-shadow"
+	TextTransform  Property = "text-transform"
+	Direction      Property = "direction"
+	UnicodeBidi    Property = "unicode-bidi"
+	Content        Property = "content"
+	ListStyle      Property = "list-style"
+	ListStyleType  Property = "list-style-type"
+)
+
+// Background, border and decoration properties.
+const (
+	Background              Property = "background"
+	BackgroundColor         Property = "background-color"
+	BackgroundImage         Property = "background-image"
+	BackgroundPosition      Property = "background-position"
+	BackgroundRepeat        Property = "background-repeat"
+	BackgroundSize          Property = "background-size"
+	Border                  Property = "border"
+	BorderTop               Property = "border-top"
+	BorderRight             Property = "border-right"
+	BorderBottom            Property = "border-bottom"
+	BorderLeft              Property = "border-left"
+	BorderColor             Property = "border-color"
+	BorderStyle             Property = "border-style"
+	BorderWidth             Property = "border-width"
+	BorderRadius            Property = "border-radius"
+	BorderTopLeftRadius     Property = "border-top-left-radius"
+	BorderTopRightRadius    Property = "border-top-right-radius"
+	BorderBottomLeftRadius  Property = "border-bottom-left-radius"
+	BorderBottomRightRadius Property = "border-bottom-right-radius"
+	BoxShadow               Property = "box-shadow"
+	Outline                 Property = "outline"
+	OutlineOffset           Property = "outline-offset"
+	Opacity                 Property = "opacity"
+	Cursor                  Property = "cursor"
+	PointerEvents           Property = "pointer-events"
+	UserSelect              Property = "user-select"
+	Appearance              Property = "appearance"
+)
+
+// Animation and transform properties.
+const (
+	Transform                Property = "transform"
+	TransformOrigin          Property = "transform-origin"
+	Transition               Property = "transition"
+	TransitionDelay          Property = "transition-delay"
+	TransitionDuration       Property = "transition-duration"
+	TransitionProperty       Property = "transition-property"
+	TransitionTimingFunction Property = "transition-timing-function"
+	Animation                Property = "animation"
+	AnimationDelay           Property = "animation-delay"
+	AnimationDuration        Property = "animation-duration"
+	AnimationName            Property = "animation-name"
+	AnimationTimingFunction  Property = "animation-timing-function"
+	Filter                   Property = "filter"
+	BackdropFilter           Property = "backdrop-filter"
+	WillChange               Property = "will-change"
+)
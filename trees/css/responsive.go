@@ -0,0 +1,299 @@
+package css
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"text/template"
+
+	"github.com/gu-io/gu/common"
+)
+
+const (
+	defaultBaseFontSize = 16
+	defaultBaseScale    = 1.333
+)
+
+// defaultResponsive defines the Responsive helpers of the default theme, used by
+// the package level helpers and by rules without a theme of their own.
+var defaultResponsive = ResponsiveFor(common.Theme{})
+
+// Responsive provides the breakpoint, container and scale helpers for the
+// Breakpoints, BaseFontSize and BaseScale of a theme. A Rule uses the helpers of
+// the theme set through Rule.UseTheme for its template functions and for the
+// at-rules of its styles.
+type Responsive struct {
+	theme common.Theme
+}
+
+// ResponsiveFor returns the Responsive helpers for the theme, using the default
+// BaseFontSize and BaseScale when the theme has none and the DefaultBreakpoints
+// when it declares no breakpoints.
+func ResponsiveFor(theme common.Theme) Responsive {
+	if theme.BaseFontSize <= 0 {
+		theme.BaseFontSize = defaultBaseFontSize
+	}
+
+	if theme.BaseScale <= 0 {
+		theme.BaseScale = defaultBaseScale
+	}
+
+	return Responsive{theme: theme}
+}
+
+// funcs returns the template functions of the helpers.
+func (rs Responsive) funcs() template.FuncMap {
+	return template.FuncMap{
+		"breakpoint":   rs.BreakpointWidth,
+		"media":        rs.MediaQuery,
+		"mediaDown":    rs.MediaDownQuery,
+		"mediaBetween": rs.MediaBetweenQuery,
+		"container": func(name string) (string, error) {
+			return rs.ContainerQuery("", name)
+		},
+		"containerNamed": rs.ContainerQuery,
+		"scale":          rs.Scale,
+		"spacing":        rs.Spacing,
+		"typeScale":      rs.TypeScale,
+		"fluidSpacing":   rs.FluidSpacing,
+		"fluidType":      rs.FluidType,
+	}
+}
+
+//==============================================================================
+
+// BreakpointWidth returns the minimum width in pixels of the named breakpoint
+// else returning an error if the theme has no such breakpoint.
+func (rs Responsive) BreakpointWidth(name string) (int, error) {
+	width, ok := rs.theme.Breakpoint(name)
+	if !ok {
+		return 0, fmt.Errorf("Unknown breakpoint %q", name)
+	}
+
+	return width, nil
+}
+
+// MediaQuery returns a @media query matching screens from the named breakpoint
+// and up e.g `@media (min-width: 768px)`.
+func (rs Responsive) MediaQuery(name string) (string, error) {
+	width, err := rs.BreakpointWidth(name)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("@media (min-width: %dpx)", width), nil
+}
+
+// MediaDownQuery returns a @media query matching screens below the named
+// breakpoint e.g `@media (max-width: 767px)`.
+func (rs Responsive) MediaDownQuery(name string) (string, error) {
+	width, err := rs.BreakpointWidth(name)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("@media (max-width: %dpx)", width-1), nil
+}
+
+// MediaBetweenQuery returns a @media query matching screens from the first named
+// breakpoint up until the second.
+func (rs Responsive) MediaBetweenQuery(from string, to string) (string, error) {
+	min, err := rs.BreakpointWidth(from)
+	if err != nil {
+		return "", err
+	}
+
+	max, err := rs.BreakpointWidth(to)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("@media (min-width: %dpx) and (max-width: %dpx)", min, max-1), nil
+}
+
+// ContainerQuery returns a @container query matching containers from the named
+// breakpoint and up, if container is not empty then the query targets the
+// container with the giving name.
+func (rs Responsive) ContainerQuery(container string, name string) (string, error) {
+	width, err := rs.BreakpointWidth(name)
+	if err != nil {
+		return "", err
+	}
+
+	if container == "" {
+		return fmt.Sprintf("@container (min-width: %dpx)", width), nil
+	}
+
+	return fmt.Sprintf("@container %s (min-width: %dpx)", container, width), nil
+}
+
+// Scale returns the BaseScale of the theme raised to the giving step, where
+// negative steps return values smaller than 1.
+func (rs Responsive) Scale(step int) float64 {
+	return math.Pow(rs.theme.BaseScale, float64(step))
+}
+
+// Spacing returns the rem value of the giving step on the spacing scale, where
+// step 0 is half of the base font size.
+func (rs Responsive) Spacing(step int) string {
+	return Rem(round(rs.Scale(step) / 2))
+}
+
+// TypeScale returns the rem value of the giving step on the type scale, where
+// step 0 is the base font size.
+func (rs Responsive) TypeScale(step int) string {
+	return Rem(round(rs.Scale(step)))
+}
+
+// FluidSpacing returns a clamp() value which grows the spacing from the previous
+// step on the smallest breakpoint to the giving step on the largest breakpoint.
+func (rs Responsive) FluidSpacing(step int) string {
+	return rs.fluid(rs.Scale(step-1)/2, rs.Scale(step)/2)
+}
+
+// FluidType returns a clamp() value which grows the font size from the previous
+// step on the smallest breakpoint to the giving step on the largest breakpoint.
+func (rs Responsive) FluidType(step int) string {
+	return rs.fluid(rs.Scale(step-1), rs.Scale(step))
+}
+
+//==============================================================================
+
+// BreakpointWidth returns the minimum width in pixels of the named breakpoint
+// within the DefaultBreakpoints.
+func BreakpointWidth(name string) (int, error) {
+	return defaultResponsive.BreakpointWidth(name)
+}
+
+// MediaQuery returns a @media query matching screens from the named breakpoint
+// of the DefaultBreakpoints and up.
+func MediaQuery(name string) (string, error) {
+	return defaultResponsive.MediaQuery(name)
+}
+
+// MediaDownQuery returns a @media query matching screens below the named
+// breakpoint of the DefaultBreakpoints.
+func MediaDownQuery(name string) (string, error) {
+	return defaultResponsive.MediaDownQuery(name)
+}
+
+// MediaBetweenQuery returns a @media query matching screens between the named
+// breakpoints of the DefaultBreakpoints.
+func MediaBetweenQuery(from string, to string) (string, error) {
+	return defaultResponsive.MediaBetweenQuery(from, to)
+}
+
+// ContainerQuery returns a @container query matching containers from the named
+// breakpoint of the DefaultBreakpoints and up.
+func ContainerQuery(container string, name string) (string, error) {
+	return defaultResponsive.ContainerQuery(container, name)
+}
+
+// Scale returns the default BaseScale raised to the giving step.
+func Scale(step int) float64 {
+	return defaultResponsive.Scale(step)
+}
+
+// Spacing returns the rem value of the giving step on the default spacing scale.
+func Spacing(step int) string {
+	return defaultResponsive.Spacing(step)
+}
+
+// TypeScale returns the rem value of the giving step on the default type scale.
+func TypeScale(step int) string {
+	return defaultResponsive.TypeScale(step)
+}
+
+// FluidSpacing returns a clamp() value for the giving step on the default
+// spacing scale.
+func FluidSpacing(step int) string {
+	return defaultResponsive.FluidSpacing(step)
+}
+
+// FluidType returns a clamp() value for the giving step on the default type
+// scale.
+func FluidType(step int) string {
+	return defaultResponsive.FluidType(step)
+}
+
+//==============================================================================
+
+// MediaUp returns a Style which applies the provided styles to screens from the
+// named breakpoint and up. The breakpoint is resolved from the theme of the rule
+// the style is built into, where an unknown breakpoint fails the stylesheet of
+// the rule.
+func MediaUp(name string, styles ...*Style) *Style {
+	return atRule(func(rs Responsive) (string, error) {
+		return rs.MediaQuery(name)
+	}, styles)
+}
+
+// MediaDown returns a Style which applies the provided styles to screens below
+// the named breakpoint, resolved as MediaUp does.
+func MediaDown(name string, styles ...*Style) *Style {
+	return atRule(func(rs Responsive) (string, error) {
+		return rs.MediaDownQuery(name)
+	}, styles)
+}
+
+// MediaBetween returns a Style which applies the provided styles to screens
+// between the named breakpoints, resolved as MediaUp does.
+func MediaBetween(from string, to string, styles ...*Style) *Style {
+	return atRule(func(rs Responsive) (string, error) {
+		return rs.MediaBetweenQuery(from, to)
+	}, styles)
+}
+
+// Container returns a Style which applies the provided styles to containers from
+// the named breakpoint and up, if container is not empty then only the container
+// with the giving name is targeted. The breakpoint is resolved as MediaUp does.
+func Container(container string, name string, styles ...*Style) *Style {
+	return atRule(func(rs Responsive) (string, error) {
+		return rs.ContainerQuery(container, name)
+	}, styles)
+}
+
+// atRule returns a Style which wraps styles in the at-rule returned by the query
+// for the theme of the rule the style is built into.
+func atRule(query func(Responsive) (string, error), styles []*Style) *Style {
+	return &Style{query: query, nested: styles}
+}
+
+//==============================================================================
+
+// fluid returns a clamp() value which linearly grows from the min rem value to
+// the max rem value between the smallest and largest breakpoints of the theme.
+func (rs Responsive) fluid(min float64, max float64) string {
+	theme := rs.theme
+
+	breakpoints := theme.Breakpoints
+	if len(breakpoints) == 0 {
+		breakpoints = common.DefaultBreakpoints
+	}
+
+	var widths []int
+	for _, width := range breakpoints {
+		widths = append(widths, width)
+	}
+
+	sort.Ints(widths)
+
+	if len(widths) < 2 || widths[0] == widths[len(widths)-1] {
+		return Rem(round(max))
+	}
+
+	base := float64(theme.BaseFontSize)
+	minWidth := float64(widths[0])
+	maxWidth := float64(widths[len(widths)-1])
+
+	slope := ((max - min) * base) / (maxWidth - minWidth)
+	intercept := ((min * base) - (slope * minWidth)) / base
+
+	return fmt.Sprintf("clamp(%s, %s + %svw, %s)", Rem(round(min)), Rem(round(intercept)), strconv.FormatFloat(round(slope*100), 'f', -1, 64), Rem(round(max)))
+}
+
+// round rounds the value to 4 decimal places.
+func round(value float64) float64 {
+	return math.Floor(value*10000+0.5) / 10000
+}
@@ -0,0 +1,259 @@
+package css
+
+import (
+	"strings"
+
+	bcss "github.com/aymerick/douceur/css"
+)
+
+// Direction defines the writing direction used when rendering stylesheets.
+type Direction string
+
+// contains the supported writing directions.
+const (
+	LTR Direction = "ltr"
+	RTL Direction = "rtl"
+)
+
+// rtlLanguages contains the languages written from right to left.
+var rtlLanguages = map[string]bool{
+	"ar":  true,
+	"arc": true,
+	"ckb": true,
+	"dv":  true,
+	"fa":  true,
+	"he":  true,
+	"ks":  true,
+	"ps":  true,
+	"sd":  true,
+	"ug":  true,
+	"ur":  true,
+	"yi":  true,
+}
+
+// DirectionFor returns the writing direction of the provided locale e.g
+// "ar-EG" and "he" return RTL.
+func DirectionFor(locale string) Direction {
+	lang := strings.ToLower(strings.TrimSpace(locale))
+	if index := strings.IndexAny(lang, "-_"); index != -1 {
+		lang = lang[:index]
+	}
+
+	if rtlLanguages[lang] {
+		return RTL
+	}
+
+	return LTR
+}
+
+func init() {
+	helpers["marginStart"] = logical("margin-inline-start")
+	helpers["marginEnd"] = logical("margin-inline-end")
+	helpers["paddingStart"] = logical("padding-inline-start")
+	helpers["paddingEnd"] = logical("padding-inline-end")
+	helpers["insetStart"] = logical("inset-inline-start")
+	helpers["insetEnd"] = logical("inset-inline-end")
+	helpers["borderStart"] = logical("border-inline-start")
+	helpers["borderEnd"] = logical("border-inline-end")
+	helpers["textStart"] = func() string {
+		return "text-align: start;"
+	}
+	helpers["textEnd"] = func() string {
+		return "text-align: end;"
+	}
+	helpers["floatStart"] = func() string {
+		return "float: inline-start;"
+	}
+	helpers["floatEnd"] = func() string {
+		return "float: inline-end;"
+	}
+}
+
+// logical returns a template function which returns a declaration of the
+// logical property with the giving value.
+func logical(property string) func(string) string {
+	return func(value string) string {
+		return property + ": " + value + ";"
+	}
+}
+
+//==============================================================================
+
+// valueFlips contains properties whose left and right keyword values are
+// swapped when flipped.
+var valueFlips = map[string]bool{
+	"float":      true,
+	"clear":      true,
+	"text-align": true,
+}
+
+// edgeShorthands contains properties whose four value form describe the top,
+// right, bottom and left edges.
+var edgeShorthands = map[string]bool{
+	"margin":         true,
+	"padding":        true,
+	"border-width":   true,
+	"border-style":   true,
+	"border-color":   true,
+	"inset":          true,
+	"scroll-margin":  true,
+	"scroll-padding": true,
+}
+
+// Flip flips the directional properties of the stylesheet from left-to-right to
+// right-to-left, modifying the rules of the stylesheet in place. Properties
+// naming a left or right side are swapped (e.g margin-left, padding-right,
+// border-top-left-radius), left and right values of float, clear and
+// text-align are swapped, and the left and right edges of four value shorthands
+// and the corners of border-radius are exchanged. Logical properties are left
+// untouched as they already follow the direction of the document, as are rules
+// whose selectors all target a direction through a [dir] attribute selector.
+func Flip(sheet *bcss.Stylesheet) *bcss.Stylesheet {
+	flipRules(sheet.Rules)
+	return sheet
+}
+
+// flipRules flips the declarations of the rules and any nested rules.
+func flipRules(rules []*bcss.Rule) {
+	for _, rule := range rules {
+		if embedsRules(rule) {
+			flipRules(rule.Rules)
+			continue
+		}
+
+		if targetsDirection(rule) {
+			continue
+		}
+
+		for _, decl := range rule.Declarations {
+			flipDeclaration(decl)
+		}
+	}
+}
+
+// targetsDirection returns true/false if all selectors of the rule target a
+// writing direction e.g [dir="rtl"] .quote, which are written for the direction
+// they target and must not be flipped.
+func targetsDirection(rule *bcss.Rule) bool {
+	if len(rule.Selectors) == 0 {
+		return false
+	}
+
+	for _, sel := range rule.Selectors {
+		if !strings.Contains(sel, "[dir=") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flipDeclaration flips the property and value of the declaration.
+func flipDeclaration(decl *bcss.Declaration) {
+	// Custom properties are named by the user and have no direction.
+	if strings.HasPrefix(decl.Property, "--") {
+		return
+	}
+
+	prefix, name := vendorPrefix(decl.Property)
+
+	switch {
+	case valueFlips[name]:
+		decl.Value = swapSides(decl.Value)
+	case edgeShorthands[name]:
+		if values := splitValues(decl.Value); len(values) == 4 {
+			values[1], values[3] = values[3], values[1]
+			decl.Value = strings.Join(values, " ")
+		}
+	case name == "border-radius":
+		decl.Value = flipRadius(decl.Value)
+	default:
+		decl.Property = prefix + swapSides(name)
+	}
+}
+
+// flipRadius exchanges the left and right corners of a border-radius value,
+// handling the horizontal and vertical radii separately.
+func flipRadius(value string) string {
+	var radii []string
+
+	for _, part := range strings.Split(value, "/") {
+		values := splitValues(part)
+
+		switch len(values) {
+		case 2:
+			values = []string{values[1], values[0]}
+		case 3:
+			values = []string{values[1], values[0], values[1], values[2]}
+		case 4:
+			values = []string{values[1], values[0], values[3], values[2]}
+		}
+
+		radii = append(radii, strings.Join(values, " "))
+	}
+
+	return strings.Join(radii, " / ")
+}
+
+// swapSides swaps the words left and right within the value.
+func swapSides(value string) string {
+	words := strings.Split(value, "-")
+
+	for index, word := range words {
+		switch word {
+		case "left":
+			words[index] = "right"
+		case "right":
+			words[index] = "left"
+		}
+	}
+
+	return strings.Join(words, "-")
+}
+
+// splitValues splits the value on spaces which are not within parenthesis, so
+// values like calc(1px + 2px) are kept whole.
+func splitValues(value string) []string {
+	var values []string
+	var depth, start int
+
+	value = strings.TrimSpace(value)
+
+	for index, char := range value {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ' ', '\t', '\n':
+			if depth > 0 {
+				continue
+			}
+
+			if start < index {
+				values = append(values, value[start:index])
+			}
+
+			start = index + 1
+		}
+	}
+
+	if start < len(value) {
+		values = append(values, value[start:])
+	}
+
+	return values
+}
+
+// vendorPrefix splits a vendor prefix like -webkit- from the property name.
+func vendorPrefix(property string) (string, string) {
+	if !strings.HasPrefix(property, "-") {
+		return "", property
+	}
+
+	if index := strings.Index(property[1:], "-"); index != -1 {
+		return property[:index+2], property[index+2:]
+	}
+
+	return "", property
+}
@@ -0,0 +1,243 @@
+package trees
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EqualOptions defines the differences ignored when comparing markup with Equal
+// and Explain, where the differences ignored by any of the options provided
+// are ignored.
+type EqualOptions struct {
+	// IgnoreUIDs ignores the uids and hashes of elements.
+	IgnoreUIDs bool
+
+	// IgnoreAttributeOrder ignores the order of attributes, styles and events,
+	// comparing them by name.
+	IgnoreAttributeOrder bool
+
+	// IgnoreWhitespace ignores text containing only whitespace and the
+	// whitespace surrounding text.
+	IgnoreWhitespace bool
+}
+
+// Equal returns true/false if the markups are equivalent trees, where their
+// tags, text, attributes, styles, events and children are equal apart from the
+// differences ignored by the options. Removed children are not compared.
+func Equal(a, b *Markup, opts ...EqualOptions) bool {
+	return len(Explain(a, b, opts...)) == 0
+}
+
+// Explain returns the differences between the markups as readable messages,
+// each prefixed by the path of the node where the difference was found e.g
+// "div/ul[0]/li[2]: text "Apples" != "Pears"", where the index of a child
+// counts the compared children of its parent with the same tag. It returns no
+// differences if the markups are equal.
+func Explain(a, b *Markup, opts ...EqualOptions) []string {
+	var differences []string
+
+	explain := func(path string, format string, args ...interface{}) {
+		differences = append(differences, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	switch {
+	case a == nil && b == nil:
+		return nil
+	case a == nil:
+		explain(b.Name(), "node only in b")
+		return differences
+	case b == nil:
+		explain(a.Name(), "node only in a")
+		return differences
+	}
+
+	explainNode(a.Name(), a, b, combineOptions(opts), explain)
+
+	return differences
+}
+
+// combineOptions returns the options ignoring the differences ignored by any
+// of the provided options.
+func combineOptions(opts []EqualOptions) EqualOptions {
+	var combined EqualOptions
+
+	for _, opt := range opts {
+		combined.IgnoreUIDs = combined.IgnoreUIDs || opt.IgnoreUIDs
+		combined.IgnoreAttributeOrder = combined.IgnoreAttributeOrder || opt.IgnoreAttributeOrder
+		combined.IgnoreWhitespace = combined.IgnoreWhitespace || opt.IgnoreWhitespace
+	}
+
+	return combined
+}
+
+// explainNode reports the differences between the markups at the path.
+func explainNode(path string, a, b *Markup, opts EqualOptions, explain func(string, string, ...interface{})) {
+	if a.Name() != b.Name() {
+		explain(path, "tag %q != %q", a.Name(), b.Name())
+		return
+	}
+
+	if textA, textB := equalText(a.TextContent(), opts), equalText(b.TextContent(), opts); textA != textB {
+		explain(path, "text %q != %q", textA, textB)
+	}
+
+	if a.Name() == "text" {
+		return
+	}
+
+	if !opts.IgnoreUIDs {
+		if a.UID() != b.UID() {
+			explain(path, "uid %q != %q", a.UID(), b.UID())
+		}
+
+		if a.Hash() != b.Hash() {
+			explain(path, "hash %q != %q", a.Hash(), b.Hash())
+		}
+	}
+
+	explainProperties(path, "attribute", a.Attributes(), b.Attributes(), opts, explain)
+	explainProperties(path, "style", a.Styles(), b.Styles(), opts, explain)
+	explainEvents(path, a.Events(), b.Events(), opts, explain)
+
+	childrenA := equalChildren(a, opts)
+	childrenB := equalChildren(b, opts)
+
+	counts := make(map[string]int)
+
+	for index := 0; index < len(childrenA) || index < len(childrenB); index++ {
+		var name string
+
+		switch {
+		case index >= len(childrenA):
+			name = childrenB[index].Name()
+		default:
+			name = childrenA[index].Name()
+		}
+
+		childPath := fmt.Sprintf("%s/%s[%d]", path, name, counts[name])
+		counts[name]++
+
+		switch {
+		case index >= len(childrenA):
+			explain(childPath, "node only in b")
+		case index >= len(childrenB):
+			explain(childPath, "node only in a")
+		default:
+			explainNode(childPath, childrenA[index], childrenB[index], opts, explain)
+		}
+	}
+}
+
+// explainProperties reports the differences between the properties of the
+// kind provided, comparing them in order unless the options ignore their
+// order.
+func explainProperties(path string, kind string, a, b []Property, opts EqualOptions, explain func(string, string, ...interface{})) {
+	renderedA := renderProperties(a)
+	renderedB := renderProperties(b)
+
+	if opts.IgnoreAttributeOrder {
+		sortProperties(renderedA)
+		sortProperties(renderedB)
+	}
+
+	for index := 0; index < len(renderedA) || index < len(renderedB); index++ {
+		switch {
+		case index >= len(renderedA):
+			explain(path, "%s %s=%q only in b", kind, renderedB[index].name, renderedB[index].value)
+		case index >= len(renderedB):
+			explain(path, "%s %s=%q only in a", kind, renderedA[index].name, renderedA[index].value)
+		case renderedA[index].name != renderedB[index].name:
+			explain(path, "%s %s != %s", kind, renderedA[index].name, renderedB[index].name)
+		case renderedA[index].value != renderedB[index].value:
+			explain(path, "%s %s %q != %q", kind, renderedA[index].name, renderedA[index].value, renderedB[index].value)
+		}
+	}
+}
+
+// explainEvents reports the differences between the types of the events,
+// comparing them in order unless the options ignore their order.
+func explainEvents(path string, a, b []Event, opts EqualOptions, explain func(string, string, ...interface{})) {
+	typesA := eventTypes(a)
+	typesB := eventTypes(b)
+
+	if opts.IgnoreAttributeOrder {
+		sort.Strings(typesA)
+		sort.Strings(typesB)
+	}
+
+	if strings.Join(typesA, " ") != strings.Join(typesB, " ") {
+		explain(path, "events %q != %q", typesA, typesB)
+	}
+}
+
+// renderedProperty defines the name and value of a rendered property.
+type renderedProperty struct {
+	name  string
+	value string
+}
+
+// renderProperties returns the names and values of the properties in order,
+// keeping properties which share a name e.g repeated class attributes.
+func renderProperties(properties []Property) []renderedProperty {
+	var rendered []renderedProperty
+
+	for _, property := range properties {
+		name, value := property.Render()
+		rendered = append(rendered, renderedProperty{name: name, value: value})
+	}
+
+	return rendered
+}
+
+// sortProperties sorts the properties by name, then by value for properties
+// which share a name.
+func sortProperties(properties []renderedProperty) {
+	sort.SliceStable(properties, func(i, j int) bool {
+		if properties[i].name != properties[j].name {
+			return properties[i].name < properties[j].name
+		}
+
+		return properties[i].value < properties[j].value
+	})
+}
+
+// eventTypes returns the types of the events in order.
+func eventTypes(events []Event) []string {
+	var types []string
+
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+
+	return types
+}
+
+// equalChildren returns the children of the markup which are compared, skipping
+// removed children and text of only whitespace if ignored.
+func equalChildren(e *Markup, opts EqualOptions) []*Markup {
+	var children []*Markup
+
+	for _, child := range e.Children() {
+		if child.Removed() {
+			continue
+		}
+
+		if opts.IgnoreWhitespace && child.Name() == "text" && strings.TrimSpace(child.TextContent()) == "" {
+			continue
+		}
+
+		children = append(children, child)
+	}
+
+	return children
+}
+
+// equalText returns the text as compared under the options.
+func equalText(text string, opts EqualOptions) string {
+	if opts.IgnoreWhitespace {
+		return strings.TrimSpace(text)
+	}
+
+	return text
+}
@@ -0,0 +1,128 @@
+package trees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// buildList returns a list of the items with the attributes applied to the list
+// in order.
+func buildList(attrs []*trees.Attribute, items ...string) *trees.Markup {
+	ul := trees.NewMarkup("ul", false)
+
+	for _, attr := range attrs {
+		attr.Apply(ul)
+	}
+
+	for _, item := range items {
+		li := trees.NewMarkup("li", false)
+		trees.NewText("%s", item).Apply(li)
+		li.Apply(ul)
+	}
+
+	return ul
+}
+
+// TestEqual validates the comparison of markup with the options of EqualOptions.
+func TestEqual(t *testing.T) {
+	classes := []*trees.Attribute{trees.NewAttr("class", "items"), trees.NewAttr("id", "fruits")}
+	reversed := []*trees.Attribute{trees.NewAttr("id", "fruits"), trees.NewAttr("class", "items")}
+
+	first := buildList(classes, "Apples", "Oranges")
+	second := buildList(classes, "Apples", "Oranges")
+
+	if trees.Equal(first, second, trees.EqualOptions{}) {
+		t.Fatalf("\t%s\t  Should have found markup with different uids unequal", failed)
+	}
+	t.Logf("\t%s\t  Should have found markup with different uids unequal", success)
+
+	if !trees.Equal(first, second, trees.EqualOptions{IgnoreUIDs: true}) {
+		t.Fatalf("\t%s\t  Should have found markup equal ignoring uids: %q", failed, trees.Explain(first, second, trees.EqualOptions{IgnoreUIDs: true}))
+	}
+	t.Logf("\t%s\t  Should have found markup equal ignoring uids", success)
+
+	if !trees.Equal(first, first.Clone(), trees.EqualOptions{}) {
+		t.Fatalf("\t%s\t  Should have found markup equal to its clone", failed)
+	}
+	t.Logf("\t%s\t  Should have found markup equal to its clone", success)
+
+	third := buildList(reversed, "Apples", "Oranges")
+
+	if trees.Equal(first, third, trees.EqualOptions{IgnoreUIDs: true}) {
+		t.Fatalf("\t%s\t  Should have found markup with attributes in different order unequal", failed)
+	}
+	t.Logf("\t%s\t  Should have found markup with attributes in different order unequal", success)
+
+	if !trees.Equal(first, third, trees.EqualOptions{IgnoreUIDs: true, IgnoreAttributeOrder: true}) {
+		t.Fatalf("\t%s\t  Should have found markup equal ignoring attribute order", failed)
+	}
+	t.Logf("\t%s\t  Should have found markup equal ignoring attribute order", success)
+
+	spaced := buildList(classes, " Apples ", "Oranges")
+	trees.NewText("\n\t").Apply(spaced)
+
+	if trees.Equal(first, spaced, trees.EqualOptions{IgnoreUIDs: true}) {
+		t.Fatalf("\t%s\t  Should have found markup with whitespace text unequal", failed)
+	}
+	t.Logf("\t%s\t  Should have found markup with whitespace text unequal", success)
+
+	if !trees.Equal(first, spaced, trees.EqualOptions{IgnoreUIDs: true, IgnoreWhitespace: true}) {
+		t.Fatalf("\t%s\t  Should have found markup equal ignoring whitespace: %q", failed, trees.Explain(first, spaced, trees.EqualOptions{IgnoreUIDs: true, IgnoreWhitespace: true}))
+	}
+	t.Logf("\t%s\t  Should have found markup equal ignoring whitespace", success)
+
+	if !trees.Equal(first, spaced, trees.EqualOptions{IgnoreUIDs: true}, trees.EqualOptions{IgnoreWhitespace: true}) {
+		t.Fatalf("\t%s\t  Should have ignored the differences ignored by any of the options", failed)
+	}
+	t.Logf("\t%s\t  Should have ignored the differences ignored by any of the options", success)
+
+	if !trees.Equal(first, first.Clone()) {
+		t.Fatalf("\t%s\t  Should have compared markup without options", failed)
+	}
+	t.Logf("\t%s\t  Should have compared markup without options", success)
+
+	repeated := buildList([]*trees.Attribute{trees.NewAttr("class", "items"), trees.NewAttr("class", "fruits")}, "Apples")
+	other := buildList([]*trees.Attribute{trees.NewAttr("class", "plants"), trees.NewAttr("class", "fruits")}, "Apples")
+	swapped := buildList([]*trees.Attribute{trees.NewAttr("class", "fruits"), trees.NewAttr("class", "items")}, "Apples")
+
+	if trees.Equal(repeated, other, trees.EqualOptions{IgnoreUIDs: true, IgnoreAttributeOrder: true}) {
+		t.Fatalf("\t%s\t  Should have compared each of the attributes sharing a name", failed)
+	}
+	t.Logf("\t%s\t  Should have compared each of the attributes sharing a name", success)
+
+	if !trees.Equal(repeated, swapped, trees.EqualOptions{IgnoreUIDs: true, IgnoreAttributeOrder: true}) {
+		t.Fatalf("\t%s\t  Should have found attributes sharing a name equal ignoring order: %q", failed, trees.Explain(repeated, swapped, trees.EqualOptions{IgnoreUIDs: true, IgnoreAttributeOrder: true}))
+	}
+	t.Logf("\t%s\t  Should have found attributes sharing a name equal ignoring order", success)
+}
+
+// TestExplain validates the differences reported between markup along with the
+// paths of their nodes.
+func TestExplain(t *testing.T) {
+	classes := []*trees.Attribute{trees.NewAttr("class", "items")}
+	opts := trees.EqualOptions{IgnoreUIDs: true}
+
+	first := buildList(classes, "Apples", "Oranges")
+	second := buildList([]*trees.Attribute{trees.NewAttr("class", "fruits")}, "Apples", "Pears", "Plums")
+
+	if differences := trees.Explain(first, first.Clone(), opts); len(differences) != 0 {
+		t.Fatalf("\t%s\t  Should have found no differences for equal markup: %q", failed, differences)
+	}
+	t.Logf("\t%s\t  Should have found no differences for equal markup", success)
+
+	expected := []string{
+		`ul: attribute class "items" != "fruits"`,
+		`ul/li[1]/text[0]: text "Oranges" != "Pears"`,
+		`ul/li[2]: node only in b`,
+	}
+
+	differences := trees.Explain(first, second, opts)
+	if strings.Join(differences, "\n") != strings.Join(expected, "\n") {
+		t.Logf("\t\tRecieved: %q\n", differences)
+		t.Logf("\t\tExpected: %q\n", expected)
+		t.Fatalf("\t%s\t  Should have explained differences with node paths", failed)
+	}
+	t.Logf("\t%s\t  Should have explained differences with node paths", success)
+}
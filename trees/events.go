@@ -64,6 +64,11 @@ type Event struct {
 	Tree                     *Markup
 	Remove                   common.Remover
 	secTarget                string
+
+	// Handler calls the callback of the event for a broadcast of the event,
+	// allowing a driver to deliver events to the markup of a single app
+	// instead of dispatching them to all subscribers.
+	Handler func(common.EventBroadcast)
 }
 
 // NewEvent returns a event object that allows registering events to eventlisteners.
@@ -92,6 +97,7 @@ func (e *Event) Target() string {
 // EventJSON defines a struct which contains the giving events and
 // and tree of the giving tree.
 type EventJSON struct {
+	EventID                  string `json:"EventID"`
 	ParentSelector           string `json:"ParentSelector"`
 	EventSelector            string `json:"EventSelector"`
 	EventName                string `json:"EventName"`
@@ -105,6 +111,7 @@ type EventJSON struct {
 // EventJSON returns the event json structure which represent the giving event.
 func (e *Event) EventJSON() EventJSON {
 	return EventJSON{
+		EventID:                  e.ID(),
 		Event:                    e.Type,
 		UseCapture:               e.UseCapture,
 		EventName:                e.EventName(),
@@ -65,6 +65,7 @@ func AbortEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -102,6 +103,7 @@ func AfterPrintEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -139,6 +141,7 @@ func AfterScriptExecuteEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -176,6 +179,7 @@ func AlertActiveEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -213,6 +217,7 @@ func AlertCloseEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -250,6 +255,7 @@ func AlertingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -287,6 +293,7 @@ func AnimationEndEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -324,6 +331,7 @@ func AnimationIterationEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -361,6 +369,7 @@ func AnimationStartEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -398,6 +407,7 @@ func AppinstalledEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -435,6 +445,7 @@ func AudioProcessEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -472,6 +483,7 @@ func AudioendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -509,6 +521,7 @@ func AudiostartEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -546,6 +559,7 @@ func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -583,6 +597,7 @@ func BeforeInstallPromptEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -620,6 +635,7 @@ func BeforePrintEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -657,6 +673,7 @@ func BeforeScriptExecuteEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -694,6 +711,7 @@ func BeforeUnloadEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -731,6 +749,7 @@ func BeginEventEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -768,6 +787,7 @@ func BlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -805,6 +825,7 @@ func BlurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -842,6 +863,7 @@ func BoundaryEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -879,6 +901,7 @@ func BroadcastEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -916,6 +939,7 @@ func BusyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -953,6 +977,7 @@ func CSSRuleViewCSSLinkClickedEvent(callback interface{}, options ...trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -990,6 +1015,7 @@ func CSSRuleViewChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1027,6 +1053,7 @@ func CSSRuleViewRefreshedEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1064,6 +1091,7 @@ func CachedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1101,6 +1129,7 @@ func CallschangedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1138,6 +1167,7 @@ func CanPlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1175,6 +1205,7 @@ func CanPlayThroughEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1212,6 +1243,7 @@ func CardstatechangeEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1249,6 +1281,7 @@ func CfstatechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1286,6 +1319,7 @@ func ChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1323,6 +1357,7 @@ func ChargingChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1360,6 +1395,7 @@ func ChargingTimeChangeEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1397,6 +1433,7 @@ func CheckboxStateChangeEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1434,6 +1471,7 @@ func CheckingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1471,6 +1509,7 @@ func ClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1508,6 +1547,7 @@ func CloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1545,6 +1585,7 @@ func CommandEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1582,6 +1623,7 @@ func CommandupdateEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1619,6 +1661,7 @@ func CompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1656,6 +1699,7 @@ func CompositionEndEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1693,6 +1737,7 @@ func CompositionStartEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1730,6 +1775,7 @@ func CompositionUpdateEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1767,6 +1813,7 @@ func ConnectingEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1804,6 +1851,7 @@ func ConnectionInfoUpdateEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1841,6 +1889,7 @@ func ContextMenuEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1878,6 +1927,7 @@ func CopyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1915,6 +1965,7 @@ func CutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1952,6 +2003,7 @@ func DOMAutoCompleteEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -1989,6 +2041,7 @@ func DOMContentLoadedEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2026,6 +2079,7 @@ func DOMFrameContentLoadedEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2063,6 +2117,7 @@ func DOMLinkAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2100,6 +2155,7 @@ func DOMLinkRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2137,6 +2193,7 @@ func DOMMenuItemActiveEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2174,6 +2231,7 @@ func DOMMenuItemInactiveEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2211,6 +2269,7 @@ func DOMMetaAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2248,6 +2307,7 @@ func DOMMetaRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2285,6 +2345,7 @@ func DOMModalDialogClosedEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2322,6 +2383,7 @@ func DOMPopupBlockedEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2359,6 +2421,7 @@ func DOMTitleChangedEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2396,6 +2459,7 @@ func DOMWillOpenModalDialogEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2433,6 +2497,7 @@ func DOMWindowCloseEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2470,6 +2535,7 @@ func DOMWindowCreatedEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2507,6 +2573,7 @@ func DatachangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2544,6 +2611,7 @@ func DataerrorEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2581,6 +2649,7 @@ func DblClickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2618,6 +2687,7 @@ func DeliveredEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2655,6 +2725,7 @@ func DeviceLightEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2692,6 +2763,7 @@ func DeviceMotionEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2729,6 +2801,7 @@ func DeviceOrientationEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2766,6 +2839,7 @@ func DeviceProximityEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2803,6 +2877,7 @@ func DevicechangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2840,6 +2915,7 @@ func DialingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2877,6 +2953,7 @@ func DisabledEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2914,6 +2991,7 @@ func DischargingTimeChangeEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2951,6 +3029,7 @@ func DisconnectedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -2988,6 +3067,7 @@ func DisconnectingEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3025,6 +3105,7 @@ func DownloadingEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3062,6 +3143,7 @@ func DragEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3099,6 +3181,7 @@ func DragEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3136,6 +3219,7 @@ func DragEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3173,6 +3257,7 @@ func DragLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3210,6 +3295,7 @@ func DragOverEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3247,6 +3333,7 @@ func DragStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3284,6 +3371,7 @@ func DropEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3321,6 +3409,7 @@ func DurationChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3358,6 +3447,7 @@ func EmptiedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3395,6 +3485,7 @@ func EnabledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3432,6 +3523,7 @@ func EndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3469,6 +3561,7 @@ func EndEventEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3506,6 +3599,7 @@ func EndedEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3543,6 +3637,7 @@ func FocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3580,6 +3675,7 @@ func FocusInEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3617,6 +3713,7 @@ func FocusOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3654,6 +3751,7 @@ func FullScreenChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3691,6 +3789,7 @@ func FullScreenErrorEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3728,6 +3827,7 @@ func FullscreenEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3765,6 +3865,7 @@ func GamepadConnectedEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3802,6 +3903,7 @@ func GamepadDisconnectedEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3839,6 +3941,7 @@ func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3876,6 +3979,7 @@ func HashChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3913,6 +4017,7 @@ func HeldEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3950,6 +4055,7 @@ func HoldingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -3987,6 +4093,7 @@ func IcccardlockerrorEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4024,6 +4131,7 @@ func IccinfochangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4061,6 +4169,7 @@ func IncomingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4098,6 +4207,7 @@ func InputEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4135,6 +4245,7 @@ func InvalidEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4172,6 +4283,7 @@ func KeyDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4209,6 +4321,7 @@ func KeyPressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4246,6 +4359,7 @@ func KeyUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4283,6 +4397,7 @@ func LanguageChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4320,6 +4435,7 @@ func LevelChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4357,6 +4473,7 @@ func LoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4394,6 +4511,7 @@ func LoadEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4431,6 +4549,7 @@ func LoadStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4468,6 +4587,7 @@ func LoadedDataEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4505,6 +4625,7 @@ func LoadedMetadataEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4542,6 +4663,7 @@ func LocalizedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4579,6 +4701,7 @@ func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4616,6 +4739,7 @@ func MarkEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4653,6 +4777,7 @@ func MessageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4690,6 +4815,7 @@ func MouseDownEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4727,6 +4853,7 @@ func MouseEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4764,6 +4891,7 @@ func MouseLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4801,6 +4929,7 @@ func MouseMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4838,6 +4967,7 @@ func MouseOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4875,6 +5005,7 @@ func MouseOverEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4912,6 +5043,7 @@ func MouseUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4949,6 +5081,7 @@ func MozAfterPaintEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -4986,6 +5119,7 @@ func MozAudioAvailableEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5023,6 +5157,7 @@ func MozBeforeResizeEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5060,6 +5195,7 @@ func MozEdgeUIGestureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5097,6 +5233,7 @@ func MozEnteredDomFullscreenEvent(callback interface{}, options ...trees.EventOp
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5134,6 +5271,7 @@ func MozGamepadButtonDownEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5171,6 +5309,7 @@ func MozGamepadButtonUpEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5208,6 +5347,7 @@ func MozMagnifyGestureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5245,6 +5385,7 @@ func MozMagnifyGestureStartEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5282,6 +5423,7 @@ func MozMagnifyGestureUpdateEvent(callback interface{}, options ...trees.EventOp
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5319,6 +5461,7 @@ func MozPressTapGestureEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5356,6 +5499,7 @@ func MozRotateGestureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5393,6 +5537,7 @@ func MozRotateGestureStartEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5430,6 +5575,7 @@ func MozRotateGestureUpdateEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5467,6 +5613,7 @@ func MozScrolledAreaChangedEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5504,6 +5651,7 @@ func MozSwipeGestureEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5541,6 +5689,7 @@ func MozTapGestureEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5578,6 +5727,7 @@ func MozbrowseractivitydoneEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5615,6 +5765,7 @@ func MozbrowserasyncscrollEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5652,6 +5803,7 @@ func MozbrowseraudioplaybackchangeEvent(callback interface{}, options ...trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5689,6 +5841,7 @@ func MozbrowsercaretstatechangedEvent(callback interface{}, options ...trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5726,6 +5879,7 @@ func MozbrowsercloseEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5763,6 +5917,7 @@ func MozbrowsercontextmenuEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5800,6 +5955,7 @@ func MozbrowserdocumentfirstpaintEvent(callback interface{}, options ...trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5837,6 +5993,7 @@ func MozbrowsererrorEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5874,6 +6031,7 @@ func MozbrowserfindchangeEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5911,6 +6069,7 @@ func MozbrowserfirstpaintEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5948,6 +6107,7 @@ func MozbrowsericonchangeEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -5985,6 +6145,7 @@ func MozbrowserloadendEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6022,6 +6183,7 @@ func MozbrowserloadstartEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6059,6 +6221,7 @@ func MozbrowserlocationchangeEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6096,6 +6259,7 @@ func MozbrowsermanifestchangeEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6133,6 +6297,7 @@ func MozbrowsermetachangeEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6170,6 +6335,7 @@ func MozbrowseropensearchEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6207,6 +6373,7 @@ func MozbrowseropentabEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6244,6 +6411,7 @@ func MozbrowseropenwindowEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6281,6 +6449,7 @@ func MozbrowserresizeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6318,6 +6487,7 @@ func MozbrowserscrollEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6355,6 +6525,7 @@ func MozbrowserscrollareachangedEvent(callback interface{}, options ...trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6392,6 +6563,7 @@ func MozbrowserscrollviewchangeEvent(callback interface{}, options ...trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6429,6 +6601,7 @@ func MozbrowsersecuritychangeEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6466,6 +6639,7 @@ func MozbrowserselectionstatechangedEvent(callback interface{}, options ...trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6503,6 +6677,7 @@ func MozbrowsershowmodalpromptEvent(callback interface{}, options ...trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6540,6 +6715,7 @@ func MozbrowsertitlechangeEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6577,6 +6753,7 @@ func MozbrowserusernameandpasswordrequiredEvent(callback interface{}, options ..
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6614,6 +6791,7 @@ func MozbrowservisibilitychangeEvent(callback interface{}, options ...trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6651,6 +6829,7 @@ func MoztimechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6688,6 +6867,7 @@ func NoUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6725,6 +6905,7 @@ func NomatchEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6762,6 +6943,7 @@ func NotificationclickEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6799,6 +6981,7 @@ func ObsoleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6836,6 +7019,7 @@ func OfflineEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6873,6 +7057,7 @@ func OnconnectedEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6910,6 +7095,7 @@ func OnlineEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6947,6 +7133,7 @@ func OpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -6984,6 +7171,7 @@ func OrientationChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7021,6 +7209,7 @@ func OverflowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7058,6 +7247,7 @@ func PageHideEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7095,6 +7285,7 @@ func PageShowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7132,6 +7323,7 @@ func PasteEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7169,6 +7361,7 @@ func PauseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7206,6 +7399,7 @@ func PlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7243,6 +7437,7 @@ func PlayingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7280,6 +7475,7 @@ func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7317,6 +7513,7 @@ func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7354,6 +7551,7 @@ func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7391,6 +7589,7 @@ func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7428,6 +7627,7 @@ func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7465,6 +7665,7 @@ func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7502,6 +7703,7 @@ func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7539,6 +7741,7 @@ func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7576,6 +7779,7 @@ func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7613,6 +7817,7 @@ func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7650,6 +7855,7 @@ func PopStateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7687,6 +7893,7 @@ func PopuphiddenEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7724,6 +7931,7 @@ func PopuphidingEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7761,6 +7969,7 @@ func PopupshowingEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7798,6 +8007,7 @@ func PopupshownEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7835,6 +8045,7 @@ func ProgressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7872,6 +8083,7 @@ func PushEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7909,6 +8121,7 @@ func PushsubscriptionchangeEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7946,6 +8159,7 @@ func RadioStateChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -7983,6 +8197,7 @@ func RateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8020,6 +8235,7 @@ func ReadystateChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8057,6 +8273,7 @@ func ReceivedEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8094,6 +8311,7 @@ func RepeatEventEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8131,6 +8349,7 @@ func RequestprogressEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8168,6 +8387,7 @@ func ResetEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8205,6 +8425,7 @@ func ResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8242,6 +8463,7 @@ func ResourcetimingbufferfullEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8279,6 +8501,7 @@ func ResponseprogressEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8316,6 +8539,7 @@ func ResultEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8353,6 +8577,7 @@ func ResumeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8390,6 +8615,7 @@ func ResumingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8427,6 +8653,7 @@ func SSTabClosingEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8464,6 +8691,7 @@ func SSTabRestoredEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8501,6 +8729,7 @@ func SSTabRestoringEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8538,6 +8767,7 @@ func SSWindowClosingEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8575,6 +8805,7 @@ func SSWindowStateBusyEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8612,6 +8843,7 @@ func SSWindowStateReadyEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8649,6 +8881,7 @@ func SVGAbortEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8686,6 +8919,7 @@ func SVGErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8723,6 +8957,7 @@ func SVGLoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8760,6 +8995,7 @@ func SVGResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8797,6 +9033,7 @@ func SVGScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8834,6 +9071,7 @@ func SVGUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8871,6 +9109,7 @@ func SVGZoomEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8908,6 +9147,7 @@ func ScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8945,6 +9185,7 @@ func SeekedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -8982,6 +9223,7 @@ func SeekingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9019,6 +9261,7 @@ func SelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9056,6 +9299,7 @@ func SelectionchangeEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9093,6 +9337,7 @@ func SelectstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9130,6 +9375,7 @@ func SentEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9167,6 +9413,7 @@ func ShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9204,6 +9451,7 @@ func SizemodechangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9241,6 +9489,7 @@ func SmartCardInsertEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9278,6 +9527,7 @@ func SmartCardRemoveEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9315,6 +9565,7 @@ func SoundendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9352,6 +9603,7 @@ func SoundstartEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9389,6 +9641,7 @@ func SpeechendEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9426,6 +9679,7 @@ func SpeechstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9463,6 +9717,7 @@ func StalledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9500,6 +9755,7 @@ func StartEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9537,6 +9793,7 @@ func StatechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9574,6 +9831,7 @@ func StatuschangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9611,6 +9869,7 @@ func StkcommandEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9648,6 +9907,7 @@ func StksessionendEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9685,6 +9945,7 @@ func StorageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9722,6 +9983,7 @@ func SubmitEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9759,6 +10021,7 @@ func SuccessEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9796,6 +10059,7 @@ func SuspendEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9833,6 +10097,7 @@ func TabCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9870,6 +10135,7 @@ func TabHideEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9907,6 +10173,7 @@ func TabOpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9944,6 +10211,7 @@ func TabPinnedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -9981,6 +10249,7 @@ func TabSelectEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10018,6 +10287,7 @@ func TabShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10055,6 +10325,7 @@ func TabUnpinnedEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10092,6 +10363,7 @@ func TimeUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10129,6 +10401,7 @@ func TimeoutEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10166,6 +10439,7 @@ func TouchCancelEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10203,6 +10477,7 @@ func TouchEndEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10240,6 +10515,7 @@ func TouchEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10277,6 +10553,7 @@ func TouchLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10314,6 +10591,7 @@ func TouchMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10351,6 +10629,7 @@ func TouchStartEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10388,6 +10667,7 @@ func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10425,6 +10705,7 @@ func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10462,6 +10743,7 @@ func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10499,6 +10781,7 @@ func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10536,6 +10819,7 @@ func UnderflowEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10573,6 +10857,7 @@ func UnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10610,6 +10895,7 @@ func UpdateReadyEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10647,6 +10933,7 @@ func UpgradeNeededEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10684,6 +10971,7 @@ func UserProximityEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10721,6 +11009,7 @@ func UssdreceivedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10758,6 +11047,7 @@ func ValueChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10795,6 +11085,7 @@ func VersionChangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10832,6 +11123,7 @@ func VisibilityChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10869,6 +11161,7 @@ func VoicechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10906,6 +11199,7 @@ func VoiceschangedEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10943,6 +11237,7 @@ func VolumeChangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -10980,6 +11275,7 @@ func VrdisplayactivateEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11017,6 +11313,7 @@ func VrdisplayblurEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11054,6 +11351,7 @@ func VrdisplayconnectEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11091,6 +11389,7 @@ func VrdisplaydeactivateEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11128,6 +11427,7 @@ func VrdisplaydisconnectEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11165,6 +11465,7 @@ func VrdisplayfocusEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11202,6 +11503,7 @@ func VrdisplaypresentchangeEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11239,6 +11541,7 @@ func WaitingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -11276,6 +11579,7 @@ func WheelEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
@@ -245,6 +245,7 @@ func %sEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 		handler(evm.Event, ev.Tree)
 	})
 
+	ev.Handler = eventHandler.Receive
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
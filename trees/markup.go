@@ -2,7 +2,9 @@ package trees
 
 import (
 	"fmt"
+	"hash/fnv"
 	"html/template"
+	"strconv"
 	"strings"
 
 	"github.com/gu-io/gu/trees/css"
@@ -25,6 +27,7 @@ type Markup struct {
 	textContent   string
 	idSelector    string
 	textContentFn func(*Markup) string
+	validateFn    func(*Markup) error
 
 	events   []Event
 	children []*Markup
@@ -69,7 +72,11 @@ func MarkdownTemplate(tml string, bind interface{}) *Markup {
 
 // CSSStylesheet provides a function that takes style rules which returns a stylesheet embeded into
 // the provided element parent and is built on the gu/css package which collects
-// necessary details from its parent to only target where it gets mounted.
+// necessary details from its parent to only target where it gets mounted. The
+// template is executed when the stylesheet is validated, or once its text is
+// first read, and its result is reused until it is validated again. A
+// stylesheet whose template fails to render is left empty, with its error
+// returned by Validate.
 func CSSStylesheet(styles interface{}, bind interface{}, ext *css.Rule, plain bool) *Markup {
 	var rs *css.Rule
 
@@ -95,16 +102,36 @@ func CSSStylesheet(styles interface{}, bind interface{}, ext *css.Rule, plain bo
 	content.allowAttributes = false
 	content.allowStyles = false
 	content.allowEvents = false
-	content.textContentFn = func(owner *Markup) string {
-		sheet, err := rs.Stylesheet(bind, owner.IDSelector(true))
+
+	sheet := &stylesheet{rule: rs, bind: bind}
+
+	content.validateFn = func(*Markup) error {
+		return sheet.render()
+	}
+
+	content.textContentFn = sheet.text
+
+	return content
+}
+
+// direction returns the writing direction set through the `dir` attribute of
+// the markup or its closest parent which has one.
+func direction(e *Markup) (css.Direction, bool) {
+	for ; e != nil; e = e.parent {
+		attr, err := GetAttr(e, "dir")
 		if err != nil {
-			return err.Error()
+			continue
 		}
 
-		return sheet.String()
+		switch _, val := attr.Render(); css.Direction(val) {
+		case css.RTL:
+			return css.RTL, true
+		case css.LTR:
+			return css.LTR, true
+		}
 	}
 
-	return content
+	return "", false
 }
 
 //==============================================================================
@@ -196,6 +223,7 @@ func (e *Markup) UnmarshalJSON(data []byte) error {
 		e.attrs = item.attrs
 		e.textContent = item.textContent
 		e.textContentFn = item.textContentFn
+		e.validateFn = item.validateFn
 		e.tagname = item.tagname
 		e.styles = item.styles
 		e.events = item.events
@@ -424,6 +452,48 @@ func (e *Markup) UpdateHash() {
 	e.hash = RandString(10)
 }
 
+// Stamp assigns deterministic uids to the children of the markup, derived from
+// the uid of their parent and their position, and sets the hash of the markup
+// and its children from their content. Stamping the same tree on the server and
+// the client produces the same uids and hashes, which allows the client to
+// adopt html rendered on the server. Children marked removed keep their uid, so
+// they can still be found and removed from the dom.
+func (e *Markup) Stamp() {
+	for index, child := range e.children {
+		if !child.Removed() {
+			child.uid = e.uid + "-" + strconv.Itoa(index)
+		}
+
+		child.Stamp()
+	}
+
+	e.hash = e.contentHash()
+}
+
+// contentHash returns a hash of the tag, text, attributes, styles and the
+// hashes of the children of the markup.
+func (e *Markup) contentHash() string {
+	hash := fnv.New64a()
+
+	fmt.Fprintf(hash, "%s|%s|", e.tagname, e.TextContent())
+
+	for _, attr := range e.attrs {
+		name, value := attr.Render()
+		fmt.Fprintf(hash, "a:%s=%s|", name, value)
+	}
+
+	for _, style := range e.styles {
+		name, value := style.Render()
+		fmt.Fprintf(hash, "s:%s=%s|", name, value)
+	}
+
+	for _, child := range e.children {
+		fmt.Fprintf(hash, "c:%s|", child.hash)
+	}
+
+	return strconv.FormatUint(hash.Sum64(), 36)
+}
+
 // Reconcile takes a old markup and reconciles its uid and its children with
 // these information,it returns a true/false telling the parent if the children
 // swapped hashes.
@@ -568,6 +638,83 @@ func (e *Markup) AddChild(child ...*Markup) {
 	}
 }
 
+// RemoveChild removes the child from the children of the element, returning
+// true/false if the child was found.
+func (e *Markup) RemoveChild(child *Markup) bool {
+	for index, ch := range e.children {
+		if ch != child {
+			continue
+		}
+
+		e.children = append(e.children[:index], e.children[index+1:]...)
+
+		if ch.parent == e {
+			ch.parent = nil
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// Validate returns the first error found within the markup and its children
+// which are not removed, e.g the error of a stylesheet whose template fails to
+// render. The skipped markups and their children are not validated, e.g the
+// trees of components composed into the markup which are validated on their
+// own.
+func (e *Markup) Validate(skip ...*Markup) error {
+	if e.validateFn != nil {
+		if err := e.validateFn(e); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range e.children {
+		if child.Removed() || containsMarkup(skip, child) {
+			continue
+		}
+
+		if err := child.Validate(skip...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// containsMarkup returns true/false if the markup is within the markups.
+func containsMarkup(markups []*Markup, markup *Markup) bool {
+	for _, item := range markups {
+		if item == markup {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReplaceChild replaces the child with the new child within the children of
+// the element, returning true/false if the child was found.
+func (e *Markup) ReplaceChild(child *Markup, newChild *Markup) bool {
+	for index, ch := range e.children {
+		if ch != child {
+			continue
+		}
+
+		if ch.parent == e {
+			ch.parent = nil
+		}
+
+		newChild.parent = e
+		e.children[index] = newChild
+
+		return true
+	}
+
+	return false
+}
+
 // EachChild iterates all children from this giving root down with all childrens
 // allowing the callback to process the child has needed.
 func (e *Markup) EachChild(fn func(*Markup)) {
@@ -710,6 +857,7 @@ func (e *Markup) CopyTo(co *Markup) {
 	// if co.textContent == "" {
 	co.textContent = e.textContent
 	co.textContentFn = e.textContentFn
+	co.validateFn = e.validateFn
 	// }
 
 	//clone the internal styles
@@ -754,6 +902,7 @@ func (e *Markup) Clone() *Markup {
 	//copy over the textContent
 	co.textContent = e.textContent
 	co.textContentFn = e.textContentFn
+	co.validateFn = e.validateFn
 	co.ID = e.ID
 	co.hash = e.hash
 	co.uid = e.uid
@@ -1,7 +1,9 @@
 package trees
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 )
@@ -155,13 +157,23 @@ func (m *ElementWriter) Write(ma *Markup) (string, error) {
 
 // Print returns the string representation of the element
 func (m *ElementWriter) Print(e *Markup) string {
+	var content bytes.Buffer
+	m.Stream(&content, e)
+	return content.String()
+}
+
+// Stream writes the html representation of the element into the writer as it
+// is generated, writing each child as it is printed instead of building the
+// full markup first, which allows large trees to be streamed to clients.
+func (m *ElementWriter) Stream(w io.Writer, e *Markup) error {
 	if e.Removed() && GetMode() > Normal {
-		return ""
+		return nil
 	}
 
 	//if we are dealing with a text type just return the content
 	if e.Name() == "text" {
-		return m.text.Print(e)
+		_, err := io.WriteString(w, m.text.Print(e))
+		return err
 	}
 
 	// Management attributes.
@@ -193,26 +205,30 @@ func (m *ElementWriter) Print(e *Markup) string {
 		closer = fmt.Sprintf("</%s>", e.Name())
 	}
 
-	var children = []string{}
+	//lets write the elements markup now
+	if _, err := io.WriteString(w, strings.Join([]string{
+		fmt.Sprintf("<%s", e.Name()),
+		hashes,
+		attrs,
+		fmt.Sprintf(` style=%q`, style),
+		beginbrack,
+		e.TextContent(),
+	}, "")); err != nil {
+		return err
+	}
+
 	for _, ch := range e.Children() {
 		if ch.UID() == e.UID() {
 			continue
 		}
 
-		children = append(children, m.Print(ch))
+		if err := m.Stream(w, ch); err != nil {
+			return err
+		}
 	}
 
-	//lets create the elements markup now
-	return strings.Join([]string{
-		fmt.Sprintf("<%s", e.Name()),
-		hashes,
-		attrs,
-		fmt.Sprintf(` style=%q`, style),
-		beginbrack,
-		e.TextContent(),
-		strings.Join(children, ""),
-		closer,
-	}, "")
+	_, err := io.WriteString(w, closer)
+	return err
 }
 
 //==============================================================================
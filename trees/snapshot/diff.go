@@ -0,0 +1,39 @@
+package snapshot
+
+import (
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// Diff returns the differences between the snapshots as reported by
+// trees.Explain, a difference per line prefixed by the path of the node
+// containing it, e.g `ul/li[1]/text[0]: text "Oranges" != "Pears"`. Snapshots
+// which differ only in their formatting are reported as such. It returns an
+// empty string if the snapshots are equal.
+func Diff(expected string, actual string) string {
+	if expected == actual {
+		return ""
+	}
+
+	differences := trees.Explain(parse(expected), parse(actual), trees.EqualOptions{
+		IgnoreUIDs:       true,
+		IgnoreWhitespace: true,
+	})
+
+	if len(differences) == 0 {
+		return "snapshots differ only in formatting\n"
+	}
+
+	return strings.Join(differences, "\n") + "\n"
+}
+
+// parse returns the markup printed into the snapshot, returning nil for an
+// empty snapshot.
+func parse(snapshot string) *trees.Markup {
+	if strings.TrimSpace(snapshot) == "" {
+		return nil
+	}
+
+	return trees.ParseFirstOrMakeRoot(snapshot)
+}
@@ -0,0 +1,232 @@
+// Package snapshot provides golden file testing for rendered markup, where the
+// markup of a view, component or tree is rendered into a normalized form and
+// compared against a golden file within the testdata directory of the package
+// being tested.
+//
+//	func TestGreeter(t *testing.T) {
+//		snapshot.Match(t, "greeter", &Greeter{Name: "Alex"})
+//	}
+//
+// Golden files are created and updated by running the tests with the -update
+// flag, e.g `go test ./... -update`.
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees"
+)
+
+var update = flag.Bool("update", false, "update golden files of snapshots")
+
+// Dir defines the directory golden files are read from and written into.
+var Dir = "testdata"
+
+// Match renders the value and compares it against the golden file with the
+// provided name, failing the test with the differences between the snapshots
+// along with the rendered snapshot if they differ.
+// The golden file is written instead when tests run with the -update flag. The
+// value must be a *trees.Markup or a gu.Renderable e.g *gu.NView.
+func Match(t testing.TB, name string, value interface{}) {
+	t.Helper()
+
+	rendered, err := Render(value)
+	if err != nil {
+		t.Fatalf("Unable to render snapshot %q: %+q", name, err)
+		return
+	}
+
+	golden := filepath.Join(Dir, name+".golden")
+
+	if *update {
+		if err := os.MkdirAll(Dir, 0755); err != nil {
+			t.Fatalf("Unable to create snapshot directory: %+q", err)
+			return
+		}
+
+		if err := ioutil.WriteFile(golden, []byte(rendered), 0644); err != nil {
+			t.Fatalf("Unable to write golden file %q: %+q", golden, err)
+		}
+
+		return
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Unable to read golden file %q, run tests with -update to create it: %+q", golden, err)
+		return
+	}
+
+	if string(expected) == rendered {
+		return
+	}
+
+	t.Fatalf("Snapshot %q does not match golden file %q:\n%s\nReceived:\n%s", name, golden, Diff(string(expected), rendered), rendered)
+}
+
+// Render returns the normalized form of the value, which must be a
+// *trees.Markup or a gu.Renderable.
+func Render(value interface{}) (string, error) {
+	switch item := value.(type) {
+	case *trees.Markup:
+		return Print(item), nil
+	case gu.Renderable:
+		return Print(item.Render()), nil
+	}
+
+	return "", errors.New("Value must be a *trees.Markup or gu.Renderable")
+}
+
+// Print returns the normalized form of the markup, which prints an element or
+// text per line indented by its depth, where auto closed elements are printed
+// as self closing tags. Hashes are stripped, attributes and
+// styles are sorted by name and events are printed as on:type markers. Uids
+// are replaced by placeholders numbered in the order of their elements, so
+// attributes referencing an element of the tree remain stable across renders.
+// Removed elements and text of only whitespace are skipped.
+func Print(markup *trees.Markup) string {
+	placeholders := make(map[string]string)
+
+	index := 0
+	visit(markup, func(node *trees.Markup) {
+		if node.UID() == "" || placeholders[node.UID()] != "" {
+			return
+		}
+
+		index++
+		placeholders[node.UID()] = fmt.Sprintf("{uid:%d}", index)
+	})
+
+	var content bytes.Buffer
+	printNode(&content, markup, 0, placeholders)
+	return content.String()
+}
+
+// visit calls the function for the markup and its children which are not
+// removed in order.
+func visit(markup *trees.Markup, fn func(*trees.Markup)) {
+	if markup.Removed() {
+		return
+	}
+
+	fn(markup)
+
+	for _, child := range markup.Children() {
+		visit(child, fn)
+	}
+}
+
+// printNode writes the normalized form of the markup into the buffer.
+func printNode(w *bytes.Buffer, markup *trees.Markup, depth int, placeholders map[string]string) {
+	if markup.Removed() {
+		return
+	}
+
+	indent := strings.Repeat("  ", depth)
+
+	if markup.Name() == "text" {
+		if text := strings.TrimSpace(markup.TextContent()); text != "" {
+			fmt.Fprintf(w, "%s%s\n", indent, html.EscapeString(text))
+		}
+
+		return
+	}
+
+	if markup.AutoClosed() {
+		fmt.Fprintf(w, "%s<%s%s />\n", indent, markup.Name(), properties(markup, placeholders))
+		return
+	}
+
+	fmt.Fprintf(w, "%s<%s%s>\n", indent, markup.Name(), properties(markup, placeholders))
+
+	if text := strings.TrimSpace(markup.TextContent()); text != "" {
+		fmt.Fprintf(w, "%s  %s\n", indent, html.EscapeString(text))
+	}
+
+	for _, child := range markup.Children() {
+		printNode(w, child, depth+1, placeholders)
+	}
+
+	fmt.Fprintf(w, "%s</%s>\n", indent, markup.Name())
+}
+
+// properties returns the attributes, styles and events of the markup in their
+// normalized form.
+func properties(markup *trees.Markup, placeholders map[string]string) string {
+	var attrs []string
+
+	for _, attr := range markup.Attributes() {
+		name, value := attr.Render()
+		if name == "data-gen" {
+			continue
+		}
+
+		attrs = append(attrs, fmt.Sprintf("%s=%q", name, replaceUIDs(value, placeholders)))
+	}
+
+	sort.Strings(attrs)
+
+	var styles []string
+
+	for _, style := range markup.Styles() {
+		name, value := style.Render()
+		styles = append(styles, fmt.Sprintf("%s: %s;", name, value))
+	}
+
+	sort.Strings(styles)
+
+	if len(styles) != 0 {
+		attrs = append(attrs, fmt.Sprintf("style=%q", strings.Join(styles, " ")))
+	}
+
+	var events []string
+
+	for _, event := range markup.Events() {
+		events = append(events, "on:"+strings.ToLower(event.Type))
+	}
+
+	sort.Strings(events)
+
+	attrs = append(attrs, events...)
+
+	if len(attrs) == 0 {
+		return ""
+	}
+
+	return " " + strings.Join(attrs, " ")
+}
+
+// replaceUIDs replaces the uids within the value with their placeholders, where
+// longer uids are replaced first as the uids of children are prefixed by the
+// uids of their parents.
+func replaceUIDs(value string, placeholders map[string]string) string {
+	uids := make([]string, 0, len(placeholders))
+	for uid := range placeholders {
+		uids = append(uids, uid)
+	}
+
+	sort.Slice(uids, func(i, j int) bool {
+		if len(uids[i]) != len(uids[j]) {
+			return len(uids[i]) > len(uids[j])
+		}
+
+		return uids[i] < uids[j]
+	})
+
+	for _, uid := range uids {
+		value = strings.Replace(value, uid, placeholders[uid], -1)
+	}
+
+	return value
+}
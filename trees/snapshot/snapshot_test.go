@@ -0,0 +1,144 @@
+package snapshot_test
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/gu-io/gu/trees/property"
+	"github.com/gu-io/gu/trees/snapshot"
+	"github.com/influx6/faux/tests"
+)
+
+type list struct {
+	items []string
+}
+
+func (l *list) Render() *trees.Markup {
+	ul := elems.UnorderedList(property.ClassAttr("items"))
+
+	for _, item := range l.items {
+		elems.ListItem(elems.Text("%s", item), events.ClickEvent(func() {})).Apply(ul)
+	}
+
+	return ul
+}
+
+// recorder defines a testing.TB which records the failure of a test instead of
+// failing it.
+type recorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+func TestPrint(t *testing.T) {
+	// children are stamped with the uid of their parent and their index, so
+	// the label has the uid root-0.
+	root := elems.Div(
+		property.IDAttr("main"),
+		trees.NewAttr("aria-describedby", "root-0"),
+		elems.Label(trees.NewAttr("for", "email"), elems.Text("  Email  ")),
+		elems.Text("   "),
+	)
+
+	root.SwapUID("root")
+	root.Stamp()
+
+	expected := strings.Join([]string{
+		`<div aria-describedby="{uid:2}" id="main">`,
+		`  <label for="email">`,
+		`    Email`,
+		`  </label>`,
+		`</div>`,
+		``,
+	}, "\n")
+
+	if printed := snapshot.Print(root); printed != expected {
+		t.Logf("\t\tRecieved: %q\n", printed)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have printed normalized markup")
+	}
+	tests.Passed("Should have printed normalized markup")
+}
+
+func TestDiff(t *testing.T) {
+	form := func(label string) string {
+		return snapshot.Print(elems.Form(
+			elems.Input(property.TypeAttr("email")),
+			elems.Label(elems.Text("%s", label)),
+		))
+	}
+
+	expected, actual := form("Email"), form("Address")
+
+	if !strings.Contains(expected, `<input type="email" />`) {
+		t.Logf("\t\tRecieved: %q\n", expected)
+		tests.Failed("Should have printed auto closed element as self closing tag")
+	}
+	tests.Passed("Should have printed auto closed element as self closing tag")
+
+	if diff := snapshot.Diff(expected, expected); diff != "" {
+		t.Logf("\t\tRecieved: %q\n", diff)
+		tests.Failed("Should have found no differences for equal snapshots")
+	}
+	tests.Passed("Should have found no differences for equal snapshots")
+
+	if diff, want := snapshot.Diff(expected, actual), "form/label[0]/text[0]: text \"Email\" != \"Address\"\n"; diff != want {
+		t.Logf("\t\tRecieved: %q\n", diff)
+		t.Logf("\t\tExpected: %q\n", want)
+		tests.Failed("Should have explained differences of snapshots with node paths")
+	}
+	tests.Passed("Should have explained differences of snapshots with node paths")
+
+	if diff := snapshot.Diff(expected, strings.Replace(expected, "  ", "\t", -1)); !strings.Contains(diff, "formatting") {
+		t.Logf("\t\tRecieved: %q\n", diff)
+		tests.Failed("Should have reported snapshots differing only in formatting")
+	}
+	tests.Passed("Should have reported snapshots differing only in formatting")
+}
+
+func TestMatch(t *testing.T) {
+	snapshot.Match(t, "list", &list{items: []string{"Apples", "Oranges"}})
+
+	app := gu.SeededApp("snapshot", "Snapshot", nil)
+	view := app.View(elems.Section(elems.Header1(elems.Text("Fruits"))), "*", gu.BodyTarget)
+	view.Component(&list{items: []string{"Apples", "Oranges"}}, gu.AnyOrder, "*", "")
+
+	snapshot.Match(t, "view", view)
+	snapshot.Match(t, "view", view)
+	tests.Passed("Should have matched golden files")
+
+	// golden files are written instead of compared when updating.
+	if flag.Lookup("update").Value.String() == "true" {
+		return
+	}
+
+	var failed recorder
+	snapshot.Match(&failed, "list", &list{items: []string{"Apples", "Pears"}})
+
+	if expected := `ul/li[1]/text[0]: text "Oranges" != "Pears"`; !strings.Contains(failed.failure, expected) {
+		t.Logf("\t\tRecieved: %s\n", failed.failure)
+		tests.Failed("Should have failed with differences containing %q", expected)
+	}
+	tests.Passed("Should have failed with differences of snapshots")
+
+	var missing recorder
+	snapshot.Match(&missing, "missing", &list{})
+
+	if !strings.Contains(missing.failure, "-update") {
+		t.Logf("\t\tRecieved: %s\n", missing.failure)
+		tests.Failed("Should have failed for missing golden file")
+	}
+	tests.Passed("Should have failed for missing golden file")
+}
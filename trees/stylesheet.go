@@ -0,0 +1,85 @@
+package trees
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gu-io/gu/trees/css"
+)
+
+// stylesheetOwner defines the selector a stylesheet is rendered for in place
+// of the selector of its parent, which is only known once the stylesheet is
+// printed within its tree.
+const stylesheetOwner = "gu-stylesheet-owner"
+
+// stylesheet defines the rule and binding of a stylesheet created by
+// CSSStylesheet, caching the result of executing its template so the template
+// runs once for each validation rather than each time its text is read.
+type stylesheet struct {
+	rule *css.Rule
+	bind interface{}
+
+	ml       sync.Mutex
+	rendered bool
+	content  string
+	err      error
+}
+
+// render executes the template of the stylesheet, caching its content or its
+// error, and returns the error.
+func (s *stylesheet) render() error {
+	var content string
+
+	sheet, err := s.rule.Stylesheet(s.bind, stylesheetOwner)
+	if err == nil {
+		content = css.Format(sheet)
+	}
+
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.rendered, s.content, s.err = true, content, err
+
+	return err
+}
+
+// result returns the cached content of the stylesheet and its error,
+// rendering the stylesheet if it was not rendered yet.
+func (s *stylesheet) result() (string, error) {
+	s.ml.Lock()
+	rendered, content, err := s.rendered, s.content, s.err
+	s.ml.Unlock()
+
+	if rendered {
+		return content, err
+	}
+
+	if err := s.render(); err != nil {
+		return "", err
+	}
+
+	return s.result()
+}
+
+// text returns the text of the stylesheet scoped to the parent of the owner
+// and printed for its writing direction, returning an empty string if its
+// template failed to render.
+func (s *stylesheet) text(owner *Markup) string {
+	content, err := s.result()
+	if err != nil {
+		return ""
+	}
+
+	content = strings.Replace(content, stylesheetOwner, owner.IDSelector(true), -1)
+
+	sheet, err := css.Plain(content, nil).Stylesheet(nil, "")
+	if err != nil {
+		return content
+	}
+
+	if dir, ok := direction(owner); ok {
+		return css.PrintDirection(sheet, dir)
+	}
+
+	return css.Print(sheet)
+}
@@ -52,6 +52,80 @@ func TestFinders(t *testing.T) {
 	t.Logf("\t%s\t  Should have been able to find a label element", success)
 }
 
+// TestStamp validates the uids and hashes assigned to a markup are derived from
+// its structure and content.
+func TestStamp(t *testing.T) {
+	first := generateMarkup()
+	first.SwapUID("root")
+	first.Stamp()
+
+	second := generateMarkup()
+	second.SwapUID("root")
+	second.Stamp()
+
+	if first.HTML() != second.HTML() {
+		t.Fatalf("\t%s\t  Should have stamped the same uids and hashes for the same markup", failed)
+	}
+	t.Logf("\t%s\t  Should have stamped the same uids and hashes for the same markup", success)
+
+	if uid := first.FirstChild().UID(); uid != "root-0" {
+		t.Logf("\t\tRecieved: %q", uid)
+		t.Logf("\t\tExpected: %q", "root-0")
+		t.Fatalf("\t%s\t  Should have derived the uid of the child from its parent and position", failed)
+	}
+	t.Logf("\t%s\t  Should have derived the uid of the child from its parent and position", success)
+
+	trees.NewAttr("class", "changed").Apply(second.FirstChild())
+	second.Stamp()
+
+	if first.Hash() == second.Hash() || first.FirstChild().Hash() == second.FirstChild().Hash() {
+		t.Fatalf("\t%s\t  Should have changed the hashes of the changed markup and its parents", failed)
+	}
+	t.Logf("\t%s\t  Should have changed the hashes of the changed markup and its parents", success)
+}
+
+func TestRemoveChild(t *testing.T) {
+	body := generateMarkup()
+	first := body.FirstChild()
+
+	if !body.RemoveChild(first) {
+		t.Fatalf("\t%s\t  Should have removed child of markup", failed)
+	}
+	t.Logf("\t%s\t  Should have removed child of markup", success)
+
+	if len(body.Children()) != 1 || body.FirstChild() == first {
+		t.Fatalf("\t%s\t  Should have left only the other children of markup", failed)
+	}
+	t.Logf("\t%s\t  Should have left only the other children of markup", success)
+
+	if body.RemoveChild(first) {
+		t.Fatalf("\t%s\t  Should have failed to remove markup which is not a child", failed)
+	}
+	t.Logf("\t%s\t  Should have failed to remove markup which is not a child", success)
+}
+
+func TestValidate(t *testing.T) {
+	body := generateMarkup()
+	failing := trees.CSSStylesheet("& { color: {{.Color}}; }", struct{}{}, nil, false)
+	failing.Apply(body.FirstChild())
+
+	if err := body.Validate(); err == nil {
+		t.Fatalf("\t%s\t  Should have returned error of stylesheet with failed template", failed)
+	}
+	t.Logf("\t%s\t  Should have returned error of stylesheet with failed template", success)
+
+	if text := failing.TextContent(); text != "" {
+		t.Logf("\t\tRecieved: %q", text)
+		t.Fatalf("\t%s\t  Should have left stylesheet with failed template empty", failed)
+	}
+	t.Logf("\t%s\t  Should have left stylesheet with failed template empty", success)
+
+	if err := body.Validate(body.FirstChild()); err != nil {
+		t.Fatalf("\t%s\t  Should have skipped validating skipped markup: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have skipped validating skipped markup", success)
+}
+
 func generateMarkup() *trees.Markup {
 	body := trees.NewMarkup("body", false)
 	trees.NewCSSStyle("width", "auto").Apply(body)
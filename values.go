@@ -0,0 +1,166 @@
+package gu
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Values defines a scope of values keyed by their types, allowing an app to
+// provide its dependencies, e.g an API client, the current user or feature
+// flags, to its components without globals. Each view has its own scope whose
+// parent is the scope of its app, so a view can override the values of the
+// app, while lookups of values not provided by a scope continue to its parent.
+// Lookups resolve the value provided at the time of the lookup, so values can
+// be swapped after components are added. Values provided for a single render,
+// e.g the current user of a request rendered through NApp.RenderFor, are
+// carried by the context of the render through WithValues and looked up by
+// LookupFor before those of the scope.
+type Values struct {
+	parent *Values
+	ml     sync.RWMutex
+	values map[reflect.Type]interface{}
+}
+
+// NewValues returns a new Values scope which looks up values missing from it
+// within the parent if provided.
+func NewValues(parent *Values) *Values {
+	return &Values{
+		parent: parent,
+		values: make(map[reflect.Type]interface{}),
+	}
+}
+
+// Provide provides the value within the scope keyed by its type, replacing
+// the value of the type provided before.
+func (v *Values) Provide(value interface{}) {
+	if value == nil {
+		panic("Values can not provide nil")
+	}
+
+	v.set(reflect.TypeOf(value), value)
+}
+
+// ProvideAs provides the value within the scope keyed by the type pointed to
+// by key, which allows providing a value as an interface it implements, e.g
+// ProvideAs((*Fetcher)(nil), client). It panics if the value is not assignable
+// to the type.
+func (v *Values) ProvideAs(key interface{}, value interface{}) {
+	v.set(keyOf(key, value), value)
+}
+
+// Swap provides the value within the scope keyed by the type pointed to by
+// key, as ProvideAs does, returning a function which restores the value the
+// scope provided before. This allows tests to replace the implementation of a
+// dependency for the duration of a test:
+//
+//	defer app.Values().Swap((*Fetcher)(nil), fakeFetcher)()
+func (v *Values) Swap(key interface{}, value interface{}) func() {
+	kind := keyOf(key, value)
+
+	v.ml.Lock()
+	previous, existed := v.values[kind]
+	v.values[kind] = value
+	v.ml.Unlock()
+
+	return func() {
+		v.ml.Lock()
+		defer v.ml.Unlock()
+
+		if existed {
+			v.values[kind] = previous
+			return
+		}
+
+		delete(v.values, kind)
+	}
+}
+
+// Lookup sets the value pointed to by target to the value of its type provided
+// by the scope or its closest parent which provides one, returning false if
+// none does.
+//
+//	var client *api.Client
+//	if !services.Values.Lookup(&client) {
+//		// no client was provided.
+//	}
+func (v *Values) Lookup(target interface{}) bool {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Ptr || pointer.IsNil() {
+		panic(fmt.Sprintf("Values can only lookup into a non-nil pointer, received %T", target))
+	}
+
+	kind := pointer.Type().Elem()
+
+	for scope := v; scope != nil; scope = scope.parent {
+		scope.ml.RLock()
+		value, ok := scope.values[kind]
+		scope.ml.RUnlock()
+
+		if ok {
+			pointer.Elem().Set(reflect.ValueOf(value))
+			return true
+		}
+	}
+
+	return false
+}
+
+// LookupFor sets the value pointed to by target as Lookup does, looking up the
+// value within the values carried by the context through WithValues before the
+// scope, returning false if neither provides one. ContextRenderables use it to
+// render values provided for the context they render within.
+//
+//	func (p *Profile) RenderFor(ctx context.Context) *trees.Markup {
+//		var user *User
+//		p.services.Values.LookupFor(ctx, &user)
+//		...
+//	}
+func (v *Values) LookupFor(ctx context.Context, target interface{}) bool {
+	if values, ok := ctx.Value(valuesKey{}).(*Values); ok && values.Lookup(target) {
+		return true
+	}
+
+	return v.Lookup(target)
+}
+
+// valuesKey defines the key of the Values carried by a context.
+type valuesKey struct{}
+
+// WithValues returns a copy of the context carrying the values, which
+// LookupFor looks up before the scope it is called on. This allows values to
+// be provided for a single render, e.g within the context of a request passed
+// to NApp.RenderFor.
+//
+//	values := gu.NewValues(nil)
+//	values.Provide(user)
+//
+//	rc, err := app.RenderFor(gu.WithValues(r.Context(), values), pe)
+func WithValues(ctx context.Context, values *Values) context.Context {
+	return context.WithValue(ctx, valuesKey{}, values)
+}
+
+// set sets the value of the type within the scope.
+func (v *Values) set(kind reflect.Type, value interface{}) {
+	v.ml.Lock()
+	defer v.ml.Unlock()
+
+	v.values[kind] = value
+}
+
+// keyOf returns the type pointed to by the key, validating that the value is
+// assignable to it.
+func keyOf(key interface{}, value interface{}) reflect.Type {
+	pointer := reflect.TypeOf(key)
+	if pointer == nil || pointer.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("Values can only be keyed by a pointer to a type, received %T", key))
+	}
+
+	kind := pointer.Elem()
+	if value == nil || !reflect.TypeOf(value).AssignableTo(kind) {
+		panic(fmt.Sprintf("Values can not provide %T as %s", value, kind))
+	}
+
+	return kind
+}
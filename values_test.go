@@ -0,0 +1,135 @@
+package gu_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+type greeter interface {
+	Greet() string
+}
+
+type english struct{}
+
+func (english) Greet() string { return "Hello" }
+
+type french struct{}
+
+func (french) Greet() string { return "Bonjour" }
+
+type german struct{}
+
+func (german) Greet() string { return "Hallo" }
+
+type flags struct {
+	Beta bool
+}
+
+type greeting struct {
+	services gu.Services
+}
+
+func (g *greeting) UseServices(services gu.Services) {
+	g.services = services
+}
+
+func (g *greeting) Render() *trees.Markup {
+	var speaker greeter
+	g.services.Values.Lookup(&speaker)
+	return elems.Text("%s", speaker.Greet())
+}
+
+func (g *greeting) RenderFor(ctx context.Context) *trees.Markup {
+	var speaker greeter
+	g.services.Values.LookupFor(ctx, &speaker)
+	return elems.Text("%s", speaker.Greet())
+}
+
+func TestValues(t *testing.T) {
+	app := gu.App("Values", nil)
+	app.Values().ProvideAs((*greeter)(nil), english{})
+	app.Values().Provide(&flags{Beta: true})
+
+	var home, paris greeting
+
+	app.View(elems.Div(), "/home", gu.BodyTarget).Component(&home, gu.AnyOrder, "*", "")
+
+	view := app.View(elems.Div(), "/paris", gu.BodyTarget)
+	view.Values().ProvideAs((*greeter)(nil), french{})
+	view.Component(&paris, gu.AnyOrder, "*", "")
+
+	if home.Render().TextContent() != "Hello" || paris.Render().TextContent() != "Bonjour" {
+		tests.Failed("Should have looked up values overridden by view")
+	}
+	tests.Passed("Should have looked up values overridden by view")
+
+	var features *flags
+	if !paris.services.Values.Lookup(&features) || !features.Beta {
+		tests.Failed("Should have looked up value provided by app")
+	}
+	tests.Passed("Should have looked up value provided by app")
+
+	var missing *english
+	if paris.services.Values.Lookup(&missing) {
+		tests.Failed("Should have found no value for type not provided")
+	}
+	tests.Passed("Should have found no value for type not provided")
+
+	restore := app.Values().Swap((*greeter)(nil), french{})
+
+	if home.Render().TextContent() != "Bonjour" {
+		tests.Failed("Should have looked up swapped value")
+	}
+	tests.Passed("Should have looked up swapped value")
+
+	restore()
+
+	if home.Render().TextContent() != "Hello" {
+		t.Logf("\t\tRecieved: %s\n", home.Render().TextContent())
+		tests.Failed("Should have restored value after swap")
+	}
+	tests.Passed("Should have restored value after swap")
+
+	pe, err := router.NewPushEvent("/home", false)
+	if err != nil {
+		tests.Failed("Should have created push event: %+q", err)
+	}
+
+	request := gu.NewValues(nil)
+	request.ProvideAs((*greeter)(nil), german{})
+
+	rc, err := app.RenderFor(gu.WithValues(context.Background(), request), pe)
+	if err != nil {
+		tests.Failed("Should have rendered app for request: %+q", err)
+	}
+
+	if content := rc.Markup.HTML(); !strings.Contains(content, "Hallo") {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have looked up value provided by context of render")
+	}
+	tests.Passed("Should have looked up value provided by context of render")
+
+	rc, err = app.RenderFor(context.Background(), pe)
+	if err != nil {
+		tests.Failed("Should have rendered app for request: %+q", err)
+	}
+
+	if content := rc.Markup.HTML(); !strings.Contains(content, "Hello") || strings.Contains(content, "Hallo") {
+		t.Logf("\t\tRecieved: %q\n", content)
+		tests.Failed("Should have looked up value of app without values in context")
+	}
+	tests.Passed("Should have looked up value of app without values in context")
+
+	var scoped *flags
+	if !paris.services.Values.LookupFor(gu.WithValues(context.Background(), request), &scoped) || !scoped.Beta {
+		tests.Failed("Should have looked up value missing from context within scope")
+	}
+	tests.Passed("Should have looked up value missing from context within scope")
+}